@@ -0,0 +1,131 @@
+package advancements_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mclib/data/pkg/data/advancements"
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requirements(groups ...[]ns.String) ns.PrefixedArray[ns.PrefixedArray[ns.String]] {
+	result := make(ns.PrefixedArray[ns.PrefixedArray[ns.String]], len(groups))
+	for i, g := range groups {
+		result[i] = g
+	}
+	return result
+}
+
+func TestAdvancementTracker(t *testing.T) {
+	tracker := advancements.NewAdvancementTracker()
+
+	add := &packets.S2CUpdateAdvancements{
+		Reset: true,
+		Added: ns.PrefixedArray[packets.AdvancementHolder]{
+			{
+				Id: "minecraft:story/root",
+				Advancement: packets.Advancement{
+					Display: ns.Some(packets.AdvancementDisplay{
+						Title:       ns.TextComponent{Text: "Minecraft"},
+						Description: ns.TextComponent{Text: "The heart and story of the game"},
+						Icon:        items.NewStackWithComponents(items.ItemID("minecraft:grass_block"), 1, &items.Components{}),
+						Frame:       packets.AdvancementFrameTask,
+						Flags:       packets.AdvancementHasBackground,
+						Background:  "minecraft:gui/advancements/backgrounds/stone",
+					}),
+					Requirements: requirements([]ns.String{"crafting_table"}),
+				},
+			},
+			{
+				Id: "minecraft:story/smelt_iron",
+				Advancement: packets.Advancement{
+					Parent: ns.Some[ns.Identifier]("minecraft:story/root"),
+					Display: ns.Some(packets.AdvancementDisplay{
+						Title:       ns.TextComponent{Text: "Acquire Hardware"},
+						Description: ns.TextComponent{Text: "Smelt an Iron Ingot"},
+						Icon:        items.NewStackWithComponents(items.ItemID("minecraft:iron_ingot"), 1, &items.Components{}),
+						Frame:       packets.AdvancementFrameGoal,
+						Flags:       packets.AdvancementShowToast,
+						X:           1,
+						Y:           2.5,
+					}),
+					Requirements: requirements([]ns.String{"iron"}, []ns.String{"furnace", "blast_furnace"}),
+				},
+			},
+			{
+				Id: "minecraft:recipes/misc/stick",
+				Advancement: packets.Advancement{
+					Parent:       ns.Some[ns.Identifier]("minecraft:recipes/root"),
+					Requirements: requirements([]ns.String{"has_planks"}),
+				},
+			},
+		},
+		Progress: ns.PrefixedArray[packets.AdvancementProgress]{
+			{Id: "minecraft:story/root", Criteria: ns.PrefixedArray[packets.CriterionProgress]{
+				{Criterion: "crafting_table", ObtainedAt: ns.Some[ns.Int64](1700000000000)},
+			}},
+			{Id: "minecraft:story/smelt_iron", Criteria: ns.PrefixedArray[packets.CriterionProgress]{
+				{Criterion: "iron"},
+				{Criterion: "furnace", ObtainedAt: ns.Some[ns.Int64](1700000001000)},
+			}},
+		},
+		ShowAdvancements: true,
+	}
+	tracker.Apply(add)
+	require.Equal(t, 3, tracker.Len())
+	assert.True(t, tracker.ShowAdvancements)
+
+	root := tracker.Get("minecraft:story/root")
+	require.NotNil(t, root)
+	assert.True(t, root.Done())
+	at, ok := root.DoneAt()
+	require.True(t, ok)
+	assert.Equal(t, time.UnixMilli(1700000000000), at)
+
+	iron := tracker.Get("minecraft:story/smelt_iron")
+	require.NotNil(t, iron)
+	assert.Equal(t, "minecraft:story/root", iron.Parent)
+	assert.Equal(t, "Acquire Hardware", iron.Display.Title.Text)
+	assert.False(t, iron.Done())
+	assert.Equal(t, [][]string{{"iron"}}, iron.Missing())
+	assert.Equal(t, []string{"iron", "blast_furnace"}, iron.Remaining())
+	assert.InDelta(t, 0.5, iron.Percent(), 1e-6)
+
+	ids := func(as []*advancements.Advancement) []string {
+		var result []string
+		for _, a := range as {
+			result = append(result, a.ID)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"minecraft:story/root"}, ids(tracker.Done()))
+	assert.Equal(t, []string{"minecraft:recipes/misc/stick", "minecraft:story/smelt_iron"}, ids(tracker.Missing()))
+	assert.Equal(t, []string{"minecraft:story/smelt_iron"}, ids(tracker.Children("minecraft:story/root")))
+	assert.Equal(t, []string{"minecraft:story/root"}, ids(tracker.Roots()))
+
+	// progress replaces the previous progress of the advancement
+	tracker.Apply(&packets.S2CUpdateAdvancements{
+		Removed: ns.PrefixedArray[ns.Identifier]{"minecraft:recipes/misc/stick"},
+		Progress: ns.PrefixedArray[packets.AdvancementProgress]{
+			{Id: "minecraft:story/smelt_iron", Criteria: ns.PrefixedArray[packets.CriterionProgress]{
+				{Criterion: "iron", ObtainedAt: ns.Some[ns.Int64](1700000002000)},
+				{Criterion: "blast_furnace", ObtainedAt: ns.Some[ns.Int64](1700000003000)},
+			}},
+			{Id: "minecraft:unknown", Criteria: ns.PrefixedArray[packets.CriterionProgress]{
+				{Criterion: "x", ObtainedAt: ns.Some[ns.Int64](1)},
+			}},
+		},
+	})
+	assert.Nil(t, tracker.Get("minecraft:recipes/misc/stick"))
+	assert.True(t, iron.Done())
+	assert.NotContains(t, iron.Obtained, "furnace")
+	assert.Empty(t, tracker.Missing())
+	assert.False(t, tracker.ShowAdvancements)
+
+	tracker.Apply(&packets.S2CUpdateAdvancements{Reset: true})
+	assert.Equal(t, 0, tracker.Len())
+}
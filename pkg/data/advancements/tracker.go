@@ -0,0 +1,201 @@
+// Package advancements tracks advancements and their progress from
+// S2CUpdateAdvancements packets.
+package advancements
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Advancement is a known advancement with its current progress.
+type Advancement struct {
+	ID string
+	// Parent is the parent advancement ID, or empty for root advancements.
+	Parent string
+	// Display is nil for advancements not shown in the advancements screen (e.g. recipes).
+	Display *packets.AdvancementDisplay
+	// Requirements lists groups of criterion names; the advancement is done
+	// when at least one criterion of every group is obtained.
+	Requirements [][]string
+	// Obtained maps obtained criteria to the time they were obtained.
+	Obtained map[string]time.Time
+}
+
+// Done reports whether every requirement group has an obtained criterion.
+// Like vanilla, an advancement without requirements is never done.
+func (a *Advancement) Done() bool {
+	if len(a.Requirements) == 0 {
+		return false
+	}
+	for _, group := range a.Requirements {
+		if !a.groupDone(group) {
+			return false
+		}
+	}
+	return true
+}
+
+// Missing returns the criteria of the requirement groups that are not yet satisfied,
+// in requirement order. Obtaining any one criterion of a group satisfies it.
+func (a *Advancement) Missing() [][]string {
+	var missing [][]string
+	for _, group := range a.Requirements {
+		if !a.groupDone(group) {
+			missing = append(missing, group)
+		}
+	}
+	return missing
+}
+
+// Remaining returns the unobtained criteria of all requirement groups, in requirement order.
+func (a *Advancement) Remaining() []string {
+	var remaining []string
+	for _, group := range a.Requirements {
+		for _, c := range group {
+			if _, ok := a.Obtained[c]; !ok && !slices.Contains(remaining, c) {
+				remaining = append(remaining, c)
+			}
+		}
+	}
+	return remaining
+}
+
+// Percent returns the fraction of satisfied requirement groups, between 0 and 1.
+func (a *Advancement) Percent() float32 {
+	if len(a.Requirements) == 0 {
+		return 0
+	}
+	return float32(len(a.Requirements)-len(a.Missing())) / float32(len(a.Requirements))
+}
+
+// DoneAt returns the time the last criterion was obtained, if the advancement is done.
+func (a *Advancement) DoneAt() (time.Time, bool) {
+	if !a.Done() {
+		return time.Time{}, false
+	}
+	var last time.Time
+	for _, t := range a.Obtained {
+		if t.After(last) {
+			last = t
+		}
+	}
+	return last, true
+}
+
+func (a *Advancement) groupDone(group []string) bool {
+	for _, c := range group {
+		if _, ok := a.Obtained[c]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// AdvancementTracker tracks advancements and their progress.
+type AdvancementTracker struct {
+	// ShowAdvancements is the last ShowAdvancements flag sent by the server.
+	ShowAdvancements bool
+	advancements     map[string]*Advancement
+}
+
+// NewAdvancementTracker creates an empty AdvancementTracker.
+func NewAdvancementTracker() *AdvancementTracker {
+	return &AdvancementTracker{advancements: make(map[string]*Advancement)}
+}
+
+// Apply applies an advancements update: reset, then removals, additions and progress.
+// Progress for unknown advancements is ignored.
+func (t *AdvancementTracker) Apply(p *packets.S2CUpdateAdvancements) {
+	if p.Reset {
+		clear(t.advancements)
+	}
+	for _, id := range p.Removed {
+		delete(t.advancements, string(id))
+	}
+	for _, h := range p.Added {
+		a := &Advancement{
+			ID:       string(h.Id),
+			Obtained: make(map[string]time.Time),
+		}
+		if parent, ok := h.Advancement.Parent.Get(); ok {
+			a.Parent = string(parent)
+		}
+		if display, ok := h.Advancement.Display.Get(); ok {
+			a.Display = &display
+		}
+		a.Requirements = make([][]string, len(h.Advancement.Requirements))
+		for i, group := range h.Advancement.Requirements {
+			a.Requirements[i] = make([]string, len(group))
+			for j, c := range group {
+				a.Requirements[i][j] = string(c)
+			}
+		}
+		t.advancements[a.ID] = a
+	}
+	for _, progress := range p.Progress {
+		a := t.advancements[string(progress.Id)]
+		if a == nil {
+			continue
+		}
+		// the server always sends the full progress of an advancement
+		clear(a.Obtained)
+		for _, c := range progress.Criteria {
+			if at, ok := c.ObtainedAt.Get(); ok {
+				a.Obtained[string(c.Criterion)] = time.UnixMilli(int64(at))
+			}
+		}
+	}
+	t.ShowAdvancements = bool(p.ShowAdvancements)
+}
+
+// Get returns the advancement with the given ID, or nil if unknown.
+func (t *AdvancementTracker) Get(id string) *Advancement {
+	return t.advancements[id]
+}
+
+// Len returns the number of known advancements.
+func (t *AdvancementTracker) Len() int {
+	return len(t.advancements)
+}
+
+// All returns all known advancements, sorted by ID.
+func (t *AdvancementTracker) All() []*Advancement {
+	return t.filter(func(*Advancement) bool { return true })
+}
+
+// Done returns the completed advancements, sorted by ID.
+func (t *AdvancementTracker) Done() []*Advancement {
+	return t.filter((*Advancement).Done)
+}
+
+// Missing returns the advancements that are not completed yet, sorted by ID.
+// Advancements without a display (such as recipe unlocks) are included too.
+func (t *AdvancementTracker) Missing() []*Advancement {
+	return t.filter(func(a *Advancement) bool { return !a.Done() })
+}
+
+// Children returns the direct children of an advancement, sorted by ID.
+func (t *AdvancementTracker) Children(id string) []*Advancement {
+	return t.filter(func(a *Advancement) bool { return a.Parent == id && a.ID != id })
+}
+
+// Roots returns the advancements without a parent (the advancement tabs), sorted by ID.
+func (t *AdvancementTracker) Roots() []*Advancement {
+	return t.filter(func(a *Advancement) bool { return a.Parent == "" })
+}
+
+func (t *AdvancementTracker) filter(keep func(*Advancement) bool) []*Advancement {
+	var result []*Advancement
+	for _, a := range t.advancements {
+		if keep(a) {
+			result = append(result, a)
+		}
+	}
+	slices.SortFunc(result, func(a, b *Advancement) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return result
+}
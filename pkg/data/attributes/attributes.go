@@ -0,0 +1,235 @@
+// Package attributes provides entity attribute metadata and evaluates attribute
+// values from S2CUpdateAttributes snapshots and equipped item modifiers.
+package attributes
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Attribute is an entity attribute.
+type Attribute struct {
+	// ID is the minecraft:attribute protocol ID.
+	ID      int32
+	Name    string
+	Default float64
+	Min     float64
+	Max     float64
+	// Syncable reports whether the server sends the attribute to the client.
+	// Non-syncable attributes, such as attack damage, must be computed locally.
+	Syncable bool
+}
+
+// Sanitize clamps a value to the attribute bounds; NaN becomes the minimum.
+func (a *Attribute) Sanitize(v float64) float64 {
+	if math.IsNaN(v) {
+		return a.Min
+	}
+	return min(max(v, a.Min), a.Max)
+}
+
+var attributesByName = func() map[string]*Attribute {
+	m := make(map[string]*Attribute, len(attributes))
+	for i := range attributes {
+		m[attributes[i].Name] = &attributes[i]
+	}
+	return m
+}()
+
+// ByID returns the attribute with the given protocol ID, or nil if unknown.
+func ByID(id int32) *Attribute {
+	if id < 0 || int(id) >= len(attributes) {
+		return nil
+	}
+	return &attributes[id]
+}
+
+// ByName returns the attribute with the given identifier, or nil if unknown.
+func ByName(name string) *Attribute {
+	return attributesByName[name]
+}
+
+// Operation is an attribute modifier operation.
+type Operation int32
+
+const (
+	AddValue           = Operation(packets.AttributeAddValue)
+	AddMultipliedBase  = Operation(packets.AttributeAddMultipliedBase)
+	AddMultipliedTotal = Operation(packets.AttributeAddMultipliedTotal)
+)
+
+var operationNames = [...]string{"add_value", "add_multiplied_base", "add_multiplied_total"}
+
+// String returns the operation name used by item components, e.g. "add_value".
+func (o Operation) String() string {
+	if o < 0 || int(o) >= len(operationNames) {
+		return "unknown"
+	}
+	return operationNames[o]
+}
+
+// ParseOperation returns the operation with the given name.
+func ParseOperation(name string) (Operation, bool) {
+	i := slices.Index(operationNames[:], name)
+	return Operation(i), i >= 0
+}
+
+// Modifier is an attribute modifier, identified by its ID.
+type Modifier struct {
+	ID        string
+	Amount    float64
+	Operation Operation
+}
+
+// AttributeInstance is the base value and modifiers of an attribute on an entity.
+type AttributeInstance struct {
+	Attribute *Attribute
+	Base      float64
+	modifiers map[string]Modifier
+}
+
+// NewAttributeInstance returns an instance of the named attribute with its default
+// base value, or nil if the attribute is unknown.
+func NewAttributeInstance(name string) *AttributeInstance {
+	attr := ByName(name)
+	if attr == nil {
+		return nil
+	}
+	return &AttributeInstance{Attribute: attr, Base: attr.Default, modifiers: make(map[string]Modifier)}
+}
+
+// FromSnapshot returns the instance described by an S2CUpdateAttributes snapshot,
+// or nil if the attribute ID is unknown.
+func FromSnapshot(s packets.AttributeSnapshot) *AttributeInstance {
+	attr := ByID(int32(s.Attribute))
+	if attr == nil {
+		return nil
+	}
+	a := &AttributeInstance{Attribute: attr, modifiers: make(map[string]Modifier, len(s.Modifiers))}
+	a.Apply(s)
+	return a
+}
+
+// Apply replaces the base value and modifiers with those of a snapshot.
+func (a *AttributeInstance) Apply(s packets.AttributeSnapshot) {
+	a.Base = float64(s.Base)
+	clear(a.modifiers)
+	for _, m := range s.Modifiers {
+		a.AddModifier(Modifier{ID: string(m.Id), Amount: float64(m.Amount), Operation: Operation(m.Operation)})
+	}
+}
+
+// AddModifier adds a modifier, replacing any modifier with the same ID.
+func (a *AttributeInstance) AddModifier(m Modifier) {
+	if a.modifiers == nil {
+		a.modifiers = make(map[string]Modifier)
+	}
+	a.modifiers[m.ID] = m
+}
+
+// RemoveModifier removes the modifier with the given ID.
+func (a *AttributeInstance) RemoveModifier(id string) {
+	delete(a.modifiers, id)
+}
+
+// Modifier returns the modifier with the given ID.
+func (a *AttributeInstance) Modifier(id string) (Modifier, bool) {
+	m, ok := a.modifiers[id]
+	return m, ok
+}
+
+// Modifiers returns the modifiers sorted by ID.
+func (a *AttributeInstance) Modifiers() []Modifier {
+	mods := make([]Modifier, 0, len(a.modifiers))
+	for _, m := range a.modifiers {
+		mods = append(mods, m)
+	}
+	slices.SortFunc(mods, func(x, y Modifier) int { return strings.Compare(x.ID, y.ID) })
+	return mods
+}
+
+// Value returns the final attribute value. Like vanilla, add_value amounts are added
+// to the base, add_multiplied_base amounts are multiplied by that sum, and each
+// add_multiplied_total amount multiplies the result by (1 + amount). The result is
+// clamped to the attribute bounds.
+func (a *AttributeInstance) Value() float64 {
+	mods := a.Modifiers()
+	base := a.Base
+	for _, m := range mods {
+		if m.Operation == AddValue {
+			base += m.Amount
+		}
+	}
+	v := base
+	for _, m := range mods {
+		if m.Operation == AddMultipliedBase {
+			v += base * m.Amount
+		}
+	}
+	for _, m := range mods {
+		if m.Operation == AddMultipliedTotal {
+			v *= 1 + m.Amount
+		}
+	}
+	return a.Attribute.Sanitize(v)
+}
+
+// Clone returns a copy of the instance.
+func (a *AttributeInstance) Clone() *AttributeInstance {
+	clone := *a
+	clone.modifiers = make(map[string]Modifier, len(a.modifiers))
+	for id, m := range a.modifiers {
+		clone.modifiers[id] = m
+	}
+	return &clone
+}
+
+// WithEquipment returns a copy of the instance with the attribute modifiers of the
+// equipped items added, as the server applies them. Modifiers already present, e.g.
+// from a server snapshot, take precedence over item modifiers with the same ID.
+func (a *AttributeInstance) WithEquipment(equipment map[packets.EquipmentSlot]*items.ItemStack) *AttributeInstance {
+	clone := a.Clone()
+	slots := make([]packets.EquipmentSlot, 0, len(equipment))
+	for slot := range equipment {
+		slots = append(slots, slot)
+	}
+	slices.Sort(slots)
+	for _, slot := range slots {
+		stack := equipment[slot]
+		if stack == nil || stack.IsEmpty() || stack.Components == nil {
+			continue
+		}
+		for _, m := range stack.Components.AttributeModifiers {
+			if m.Type != a.Attribute.Name || !SlotGroupMatches(m.Slot, slot) {
+				continue
+			}
+			if _, exists := a.modifiers[m.ID]; exists {
+				continue
+			}
+			op, ok := ParseOperation(m.Operation)
+			if !ok {
+				continue
+			}
+			clone.AddModifier(Modifier{ID: m.ID, Amount: m.Amount, Operation: op})
+		}
+	}
+	return clone
+}
+
+// SlotGroupMatches reports whether an item component slot group ("any", "hand",
+// "armor" or a slot name) includes the given equipment slot.
+func SlotGroupMatches(group string, slot packets.EquipmentSlot) bool {
+	switch group {
+	case "", "any":
+		return true
+	case "hand":
+		return slot == packets.EquipmentMainHand || slot == packets.EquipmentOffHand
+	case "armor":
+		return slot.IsArmor() || slot == packets.EquipmentBody
+	}
+	return group == slot.String()
+}
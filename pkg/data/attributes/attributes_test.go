@@ -0,0 +1,110 @@
+package attributes_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/attributes"
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSnapshot(t *testing.T) {
+	speed := attributes.ByName("minecraft:movement_speed")
+	require.NotNil(t, speed)
+
+	a := attributes.FromSnapshot(packets.AttributeSnapshot{
+		Attribute: ns.VarInt(speed.ID),
+		Base:      0.1,
+		Modifiers: ns.PrefixedArray[packets.AttributeModifier]{
+			{Id: "minecraft:sprinting", Amount: 0.3, Operation: packets.AttributeAddMultipliedTotal},
+		},
+	})
+	require.NotNil(t, a)
+	assert.Equal(t, speed, a.Attribute)
+	assert.InDelta(t, 0.13, a.Value(), 1e-9)
+
+	m, ok := a.Modifier("minecraft:sprinting")
+	require.True(t, ok)
+	assert.Equal(t, attributes.AddMultipliedTotal, m.Operation)
+}
+
+func TestAttributeMetadata(t *testing.T) {
+	a := attributes.ByName("minecraft:max_health")
+	require.NotNil(t, a)
+	assert.Equal(t, 20.0, a.Default)
+	assert.Equal(t, 1.0, a.Min)
+	assert.Equal(t, 1024.0, a.Max)
+	assert.Equal(t, a, attributes.ByID(a.ID))
+	assert.False(t, attributes.ByName("minecraft:attack_damage").Syncable)
+	assert.Nil(t, attributes.ByID(-1))
+	assert.Nil(t, attributes.NewAttributeInstance("minecraft:unknown"))
+}
+
+func TestOperationOrder(t *testing.T) {
+	a := attributes.NewAttributeInstance("minecraft:attack_damage")
+	require.NotNil(t, a)
+	a.Base = 1
+	a.AddModifier(attributes.Modifier{ID: "a", Amount: 3, Operation: attributes.AddValue})
+	a.AddModifier(attributes.Modifier{ID: "b", Amount: 0.5, Operation: attributes.AddMultipliedBase})
+	a.AddModifier(attributes.Modifier{ID: "c", Amount: 0.5, Operation: attributes.AddMultipliedBase})
+	a.AddModifier(attributes.Modifier{ID: "d", Amount: 1, Operation: attributes.AddMultipliedTotal})
+	a.AddModifier(attributes.Modifier{ID: "e", Amount: -0.25, Operation: attributes.AddMultipliedTotal})
+
+	// ((1 + 3) + 4*0.5 + 4*0.5) * 2 * 0.75
+	assert.InDelta(t, 12.0, a.Value(), 1e-9)
+
+	a.RemoveModifier("d")
+	assert.InDelta(t, 6.0, a.Value(), 1e-9)
+	assert.Len(t, a.Modifiers(), 4)
+}
+
+func TestClamping(t *testing.T) {
+	a := attributes.NewAttributeInstance("minecraft:max_health")
+	require.NotNil(t, a)
+	assert.Equal(t, 20.0, a.Value())
+
+	a.AddModifier(attributes.Modifier{ID: "boost", Amount: 5000, Operation: attributes.AddValue})
+	assert.Equal(t, 1024.0, a.Value())
+
+	a.AddModifier(attributes.Modifier{ID: "boost", Amount: -100, Operation: attributes.AddValue})
+	assert.Equal(t, 1.0, a.Value())
+}
+
+func TestWithEquipment(t *testing.T) {
+	equipment := map[packets.EquipmentSlot]*items.ItemStack{
+		packets.EquipmentMainHand: items.NewStack(items.ItemID("minecraft:diamond_sword"), 1),
+	}
+
+	damage := attributes.NewAttributeInstance("minecraft:attack_damage")
+	require.NotNil(t, damage)
+	damage.Base = 1 // player base attack damage
+	assert.InDelta(t, 7.0, damage.WithEquipment(equipment).Value(), 1e-9)
+	assert.Equal(t, 1.0, damage.Value(), "the instance itself is unchanged")
+
+	// the server snapshot already holds the sword modifier, it is not counted twice
+	speed := attributes.NewAttributeInstance("minecraft:attack_speed")
+	require.NotNil(t, speed)
+	speed.AddModifier(attributes.Modifier{ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: attributes.AddValue})
+	assert.InDelta(t, 1.6, speed.WithEquipment(equipment).Value(), 1e-9)
+
+	// a sword in the offhand does not apply its mainhand modifiers
+	offhand := map[packets.EquipmentSlot]*items.ItemStack{packets.EquipmentOffHand: equipment[packets.EquipmentMainHand]}
+	assert.Equal(t, 1.0, damage.WithEquipment(offhand).Value())
+}
+
+func TestSlotGroupMatches(t *testing.T) {
+	assert.True(t, attributes.SlotGroupMatches("any", packets.EquipmentSaddle))
+	assert.True(t, attributes.SlotGroupMatches("hand", packets.EquipmentOffHand))
+	assert.True(t, attributes.SlotGroupMatches("armor", packets.EquipmentChest))
+	assert.False(t, attributes.SlotGroupMatches("armor", packets.EquipmentMainHand))
+	assert.True(t, attributes.SlotGroupMatches("feet", packets.EquipmentFeet))
+	assert.False(t, attributes.SlotGroupMatches("head", packets.EquipmentFeet))
+
+	op, ok := attributes.ParseOperation("add_multiplied_base")
+	assert.True(t, ok)
+	assert.Equal(t, attributes.AddMultipliedBase, op)
+	assert.Equal(t, "add_multiplied_base", op.String())
+}
@@ -0,0 +1,171 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// chatTypeRegistry is the registry of chat types.
+const chatTypeRegistry = "minecraft:chat_type"
+
+// Chat type decoration parameters.
+const (
+	ParameterSender  = "sender"
+	ParameterTarget  = "target"
+	ParameterContent = "content"
+)
+
+// ParseChatType parses a chat type from the NBT of S2CRegistryData.
+func ParseChatType(tag nbt.Tag) (*packets.ChatType, error) {
+	var t packets.ChatType
+	if err := nbt.UnmarshalTag(tag, &t); err != nil {
+		return nil, fmt.Errorf("chat type: %w", err)
+	}
+	return &t, nil
+}
+
+// ParseChatTypeJSON parses a chat type in its JSON form, as found in data packs.
+func ParseChatTypeJSON(data []byte) (*packets.ChatType, error) {
+	var t packets.ChatType
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("chat type: %w", err)
+	}
+	return &t, nil
+}
+
+// VanillaChatType returns the vanilla chat type with the given name, e.g.
+// "minecraft:msg_command_incoming".
+func VanillaChatType(name string) (*packets.ChatType, error) {
+	data, ok := registries.SynchronizedRegistryData[chatTypeRegistry][name]
+	if !ok {
+		return nil, fmt.Errorf("unknown vanilla chat type %q", name)
+	}
+	return ParseChatTypeJSON(data)
+}
+
+// Decorator resolves the chat types of received messages and decorates the
+// messages like the vanilla client, e.g. "<Steve> hello" for minecraft:chat.
+type Decorator struct {
+	ra        *registries.RegistryAccess
+	chatTypes map[string]*packets.ChatType
+}
+
+// NewDecorator returns a decorator that resolves chat type IDs against the
+// minecraft:chat_type registry of ra. Chat types without data from
+// ApplyRegistryData use the vanilla definitions.
+func NewDecorator(ra *registries.RegistryAccess) *Decorator {
+	return &Decorator{ra: ra, chatTypes: make(map[string]*packets.ChatType)}
+}
+
+// ApplyRegistryData records the chat type definitions the server sent. Other
+// registries are ignored; the entry order must still be applied to the
+// RegistryAccess.
+func (d *Decorator) ApplyRegistryData(p *packets.S2CRegistryData) error {
+	if string(p.RegistryId) != chatTypeRegistry {
+		return nil
+	}
+	clear(d.chatTypes)
+	for _, e := range p.Entries {
+		if !e.HasData {
+			continue
+		}
+		t, err := ParseChatType(e.Data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.EntryId, err)
+		}
+		d.chatTypes[string(e.EntryId)] = t
+	}
+	return nil
+}
+
+// ChatType returns the chat type of a message.
+func (d *Decorator) ChatType(b *packets.ChatTypeBound) (*packets.ChatType, error) {
+	if b.Direct != nil {
+		return b.Direct, nil
+	}
+	reg := d.ra.Lookup(chatTypeRegistry)
+	if reg == nil {
+		return nil, fmt.Errorf("no %s registry", chatTypeRegistry)
+	}
+	name := reg.ByID(int32(b.ChatType))
+	if name == "" {
+		return nil, fmt.Errorf("unknown chat type ID %d", b.ChatType)
+	}
+	if t, ok := d.chatTypes[name]; ok {
+		return t, nil
+	}
+	return VanillaChatType(name)
+}
+
+// Decorate returns the message as it is shown in chat.
+func (d *Decorator) Decorate(b *packets.ChatTypeBound, content ns.TextComponent) (ns.TextComponent, error) {
+	t, err := d.ChatType(b)
+	if err != nil {
+		return content, err
+	}
+	return Decorate(t.Chat, b, content), nil
+}
+
+// Narrate returns the message as it is read out by the narrator.
+func (d *Decorator) Narrate(b *packets.ChatTypeBound, content ns.TextComponent) (ns.TextComponent, error) {
+	t, err := d.ChatType(b)
+	if err != nil {
+		return content, err
+	}
+	return Decorate(t.Narration, b, content), nil
+}
+
+// PlayerChat decorates a player chat message. Like the vanilla client, it shows
+// the unsigned content instead of the signed content if the server sent one.
+func (d *Decorator) PlayerChat(p *packets.S2CPlayerChat) (ns.TextComponent, error) {
+	content := ns.TextComponent{Text: string(p.Body.Content)}
+	if p.UnsignedContent.Present {
+		content = p.UnsignedContent.Value
+	}
+	return d.Decorate(&p.ChatType, content)
+}
+
+// DisguisedChat decorates a disguised chat message.
+func (d *Decorator) DisguisedChat(p *packets.S2CDisguisedChat) (ns.TextComponent, error) {
+	return d.Decorate(&p.ChatType, p.Message)
+}
+
+// Decorate fills in the translation of a decoration with the sender, target and
+// content, and applies its style. A missing target is left empty.
+func Decorate(dec packets.ChatTypeDecoration, b *packets.ChatTypeBound, content ns.TextComponent) ns.TextComponent {
+	args := make([]ns.TextComponent, len(dec.Parameters))
+	for i, param := range dec.Parameters {
+		switch param {
+		case ParameterSender:
+			args[i] = b.Name
+		case ParameterTarget:
+			if b.TargetName.Present {
+				args[i] = b.TargetName.Value
+			}
+		case ParameterContent:
+			args[i] = content
+		}
+	}
+	return withStyle(ns.TextComponent{Translate: dec.TranslationKey, With: args}, dec.Style)
+}
+
+// withStyle sets the style fields of style on tc.
+func withStyle(tc, style ns.TextComponent) ns.TextComponent {
+	tc.Color = style.Color
+	tc.Bold = style.Bold
+	tc.Italic = style.Italic
+	tc.Underlined = style.Underlined
+	tc.Strikethrough = style.Strikethrough
+	tc.Obfuscated = style.Obfuscated
+	tc.Font = style.Font
+	tc.Insertion = style.Insertion
+	tc.ClickEvent = style.ClickEvent
+	tc.HoverEvent = style.HoverEvent
+	return tc
+}
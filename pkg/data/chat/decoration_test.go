@@ -0,0 +1,96 @@
+package chat_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/chat"
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDecorator(t *testing.T) *chat.Decorator {
+	t.Helper()
+	ra := registries.NewRegistryAccess()
+	_, err := ra.ApplyRegistryData("minecraft:chat_type", []string{
+		"minecraft:chat", "minecraft:msg_command_incoming", "minecraft:team_msg_command_outgoing", "custom:shout",
+	})
+	require.NoError(t, err)
+	return chat.NewDecorator(ra)
+}
+
+func TestDecoratePlayerChat(t *testing.T) {
+	d := newDecorator(t)
+	steve := ns.TextComponent{Text: "Steve"}
+
+	p := &packets.S2CPlayerChat{
+		Body:     packets.SignedMessageBody{Content: "hello"},
+		ChatType: packets.ChatTypeBound{ChatType: 0, Name: steve},
+	}
+	msg, err := d.PlayerChat(p)
+	require.NoError(t, err)
+	assert.Equal(t, ns.TextComponent{Translate: "chat.type.text", With: []ns.TextComponent{steve, {Text: "hello"}}}, msg)
+
+	// whispers are styled, and the server may replace the signed content
+	p.ChatType.ChatType = 1
+	p.UnsignedContent = ns.Some(ns.TextComponent{Text: "hi", Color: "red"})
+	msg, err = d.PlayerChat(p)
+	require.NoError(t, err)
+	assert.Equal(t, "commands.message.display.incoming", msg.Translate)
+	assert.Equal(t, "gray", msg.Color)
+	require.NotNil(t, msg.Italic)
+	assert.True(t, *msg.Italic)
+	assert.Equal(t, []ns.TextComponent{steve, {Text: "hi", Color: "red"}}, msg.With)
+
+	narration, err := d.Narrate(&p.ChatType, ns.TextComponent{Text: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, "chat.type.text.narrate", narration.Translate)
+
+	// team messages take the target first
+	team := &packets.S2CDisguisedChat{
+		Message:  ns.TextComponent{Text: "gg"},
+		ChatType: packets.ChatTypeBound{ChatType: 2, Name: steve, TargetName: ns.Some(ns.TextComponent{Text: "Red"})},
+	}
+	msg, err = d.DisguisedChat(team)
+	require.NoError(t, err)
+	assert.Equal(t, []ns.TextComponent{{Text: "Red"}, steve, {Text: "gg"}}, msg.With)
+
+	_, err = d.Decorate(&packets.ChatTypeBound{ChatType: 3}, ns.TextComponent{})
+	assert.Error(t, err, "custom:shout has no vanilla definition")
+	_, err = d.Decorate(&packets.ChatTypeBound{ChatType: 9}, ns.TextComponent{})
+	assert.Error(t, err)
+}
+
+func TestDecorateCustomChatType(t *testing.T) {
+	d := newDecorator(t)
+	decoration := nbt.Compound{
+		"translation_key": nbt.String("chat.type.announcement"),
+		"parameters":      nbt.List{ElementType: nbt.TagString, Elements: []nbt.Tag{nbt.String("sender"), nbt.String("content")}},
+		"style":           nbt.Compound{"bold": nbt.Byte(1)},
+	}
+	require.NoError(t, d.ApplyRegistryData(&packets.S2CRegistryData{
+		RegistryId: "minecraft:chat_type",
+		Entries: []packets.RegistryEntry{
+			{EntryId: "minecraft:chat"},
+			{EntryId: "custom:shout", HasData: true, Data: nbt.Compound{"chat": decoration, "narration": decoration}},
+		},
+	}))
+	msg, err := d.Decorate(&packets.ChatTypeBound{ChatType: 3, Name: ns.TextComponent{Text: "Alex"}}, ns.TextComponent{Text: "HEY"})
+	require.NoError(t, err)
+	assert.Equal(t, "chat.type.announcement", msg.Translate)
+	require.NotNil(t, msg.Bold)
+	assert.True(t, *msg.Bold)
+
+	// chat types can also be sent inline
+	direct, err := chat.VanillaChatType("minecraft:say_command")
+	require.NoError(t, err)
+	msg, err = d.DisguisedChat(&packets.S2CDisguisedChat{
+		Message:  ns.TextComponent{Text: "restarting"},
+		ChatType: packets.ChatTypeBound{ChatType: -1, Direct: direct, Name: ns.TextComponent{Text: "Server"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "chat.type.announcement", msg.Translate)
+}
@@ -0,0 +1,280 @@
+package chat
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// LastSeenWindow is the number of recent messages a last-seen list can
+// acknowledge.
+const LastSeenWindow = 20
+
+// ackThreshold is the number of unacknowledged messages after which the client
+// sends C2SChatAck instead of waiting for its next message.
+const ackThreshold = 64
+
+// Checksum returns the checksum of a last-seen list that is sent alongside the
+// acknowledgement bitset. It is never 0, which the server treats as "no checksum".
+func Checksum(lastSeen []packets.MessageSignature) int8 {
+	h := int32(1)
+	for _, sig := range lastSeen {
+		h = 31*h + signatureHash(sig)
+	}
+	if int8(h) == 0 {
+		return 1
+	}
+	return int8(h)
+}
+
+// signatureHash is Java's Arrays.hashCode of the signature bytes.
+func signatureHash(sig packets.MessageSignature) int32 {
+	h := int32(1)
+	for _, b := range sig {
+		h = 31*h + int32(int8(b))
+	}
+	return h
+}
+
+type trackedMessage struct {
+	sig packets.MessageSignature
+	// pending is set until the message is acknowledged by an outgoing message.
+	pending bool
+}
+
+// LastSeenTracker is the client's window of the last LastSeenWindow signed
+// messages it received, which its own messages acknowledge.
+type LastSeenTracker struct {
+	entries [LastSeenWindow]*trackedMessage
+	tail    int
+	offset  int
+	last    *packets.MessageSignature
+}
+
+// LastSeenUpdate is the last-seen state an outgoing message signs and sends.
+type LastSeenUpdate struct {
+	// LastSeen are the acknowledged signatures, oldest first, as signed.
+	LastSeen []packets.MessageSignature
+	// Offset is the number of messages received since the previous update.
+	Offset       int32
+	Acknowledged *ns.FixedBitSet
+	Checksum     int8
+}
+
+// Add records a received signature. Messages that weren't shown, e.g. from a
+// blocked player, take up a slot without being acknowledged. It returns false if
+// the signature repeats the previous one.
+func (t *LastSeenTracker) Add(sig packets.MessageSignature, shown bool) bool {
+	if t.last != nil && *t.last == sig {
+		return false
+	}
+	t.last = &sig
+	var entry *trackedMessage
+	if shown {
+		entry = &trackedMessage{sig: sig, pending: true}
+	}
+	t.entries[t.tail] = entry
+	t.tail = (t.tail + 1) % len(t.entries)
+	t.offset++
+	return true
+}
+
+// Ignore stops tracking a message that was not yet acknowledged, e.g. because it
+// was deleted before being shown.
+func (t *LastSeenTracker) Ignore(sig packets.MessageSignature) {
+	for i, e := range t.entries {
+		if e != nil && e.pending && e.sig == sig {
+			t.entries[i] = nil
+			return
+		}
+	}
+}
+
+// Offset returns the number of messages received since the last update or
+// acknowledgement.
+func (t *LastSeenTracker) Offset() int {
+	return t.offset
+}
+
+// TakeOffset returns the offset and resets it, for a C2SChatAck.
+func (t *LastSeenTracker) TakeOffset() int {
+	offset := t.offset
+	t.offset = 0
+	return offset
+}
+
+// Update acknowledges the tracked messages for an outgoing message.
+func (t *LastSeenTracker) Update() LastSeenUpdate {
+	u := LastSeenUpdate{Offset: int32(t.TakeOffset()), Acknowledged: ns.NewFixedBitSet(LastSeenWindow)}
+	for i := range t.entries {
+		j := (t.tail + i) % len(t.entries)
+		e := t.entries[j]
+		if e == nil {
+			continue
+		}
+		u.Acknowledged.Set(i)
+		u.LastSeen = append(u.LastSeen, e.sig)
+		t.entries[j] = &trackedMessage{sig: e.sig}
+	}
+	u.Checksum = Checksum(u.LastSeen)
+	return u
+}
+
+// ProfileKeys is the profile key pair of a player, as issued by the Mojang
+// player certificates service.
+type ProfileKeys struct {
+	PrivateKey *rsa.PrivateKey
+	// PublicKey is the X.509 encoded public key.
+	PublicKey []byte
+	// ExpiresAt is the key expiry in epoch milliseconds.
+	ExpiresAt int64
+	// KeySignature is Mojang's signature of the public key.
+	KeySignature []byte
+}
+
+// SignedArgument is a command argument that is signed like a chat message, such
+// as the message of /msg.
+type SignedArgument struct {
+	Name  string
+	Value string
+}
+
+// ChatSession signs the outgoing chat of a client and acknowledges the signed
+// messages it receives.
+type ChatSession struct {
+	Player ns.UUID
+	ID     ns.UUID
+	Keys   ProfileKeys
+	// Now returns the message timestamps; defaults to time.Now.
+	Now func() time.Time
+	// Rand is the source of salts and signature randomness; defaults to crypto/rand.
+	Rand io.Reader
+
+	tracker   LastSeenTracker
+	nextIndex int32
+}
+
+// NewChatSession starts a chat session with a random ID.
+func NewChatSession(player ns.UUID, keys ProfileKeys) (*ChatSession, error) {
+	s := &ChatSession{Player: player, Keys: keys, Now: time.Now, Rand: rand.Reader}
+	if _, err := io.ReadFull(s.Rand, s.ID[:]); err != nil {
+		return nil, err
+	}
+	s.ID[6] = s.ID[6]&0x0f | 0x40 // version 4
+	s.ID[8] = s.ID[8]&0x3f | 0x80 // variant 10
+	return s, nil
+}
+
+// SessionUpdate returns the packet that announces the session to the server.
+// It must be sent before the first signed message.
+func (s *ChatSession) SessionUpdate() *packets.C2SChatSessionUpdate {
+	return &packets.C2SChatSessionUpdate{
+		SessionId:    s.ID,
+		ExpiresAt:    ns.Int64(s.Keys.ExpiresAt),
+		PublicKey:    s.Keys.PublicKey,
+		KeySignature: s.Keys.KeySignature,
+	}
+}
+
+// Tracker returns the last-seen tracker of the session.
+func (s *ChatSession) Tracker() *LastSeenTracker {
+	return &s.tracker
+}
+
+// HandlePlayerChat tracks the signature of a received message. It returns the
+// C2SChatAck to send once too many messages are unacknowledged, or nil.
+func (s *ChatSession) HandlePlayerChat(p *packets.S2CPlayerChat) *packets.C2SChatAck {
+	if !p.Signature.Present {
+		return nil
+	}
+	if s.tracker.Add(p.Signature.Value, true) && s.tracker.Offset() > ackThreshold {
+		return s.Acknowledge()
+	}
+	return nil
+}
+
+// Acknowledge returns a C2SChatAck for the messages received since the last
+// update, or nil if there are none.
+func (s *ChatSession) Acknowledge() *packets.C2SChatAck {
+	offset := s.tracker.TakeOffset()
+	if offset == 0 {
+		return nil
+	}
+	return &packets.C2SChatAck{MessageCount: ns.VarInt(offset)}
+}
+
+// Chat signs a chat message, acknowledging the messages seen so far.
+func (s *ChatSession) Chat(message string) (*packets.C2SChat, error) {
+	timestamp, salt, err := s.stamp()
+	if err != nil {
+		return nil, err
+	}
+	update := s.tracker.Update()
+	sig, err := s.sign(Body{Content: message, Timestamp: timestamp, Salt: salt, LastSeen: update.LastSeen})
+	if err != nil {
+		return nil, err
+	}
+	return &packets.C2SChat{
+		Message:      ns.String(message),
+		Timestamp:    ns.Int64(timestamp),
+		Salt:         ns.Int64(salt),
+		Signature:    ns.Some(ns.ByteArray(sig[:])),
+		MessageCount: ns.VarInt(update.Offset),
+		Acknowledged: update.Acknowledged,
+		Checksum:     ns.Int8(update.Checksum),
+	}, nil
+}
+
+// Command signs the signed arguments of a command, which is given without the
+// leading slash. Each argument is signed as a message of the chain. Commands
+// without signed arguments are sent as an unsigned C2SChatCommand instead.
+func (s *ChatSession) Command(command string, args ...SignedArgument) (*packets.C2SChatCommandSigned, error) {
+	timestamp, salt, err := s.stamp()
+	if err != nil {
+		return nil, err
+	}
+	update := s.tracker.Update()
+	p := &packets.C2SChatCommandSigned{
+		Command:            ns.String(command),
+		Timestamp:          ns.Int64(timestamp),
+		Salt:               ns.Int64(salt),
+		ArgumentSignatures: make([]packets.ArgumentSignature, len(args)),
+		MessageCount:       ns.VarInt(update.Offset),
+		Acknowledged:       update.Acknowledged,
+		Checksum:           ns.Int8(update.Checksum),
+	}
+	for i, arg := range args {
+		sig, err := s.sign(Body{Content: arg.Value, Timestamp: timestamp, Salt: salt, LastSeen: update.LastSeen})
+		if err != nil {
+			return nil, fmt.Errorf("argument %s: %w", arg.Name, err)
+		}
+		p.ArgumentSignatures[i] = packets.ArgumentSignature{Name: ns.String(arg.Name), Signature: sig}
+	}
+	return p, nil
+}
+
+func (s *ChatSession) stamp() (timestamp, salt int64, err error) {
+	var b [8]byte
+	if _, err := io.ReadFull(s.Rand, b[:]); err != nil {
+		return 0, 0, err
+	}
+	return s.Now().UnixMilli(), int64(binary.BigEndian.Uint64(b[:])), nil
+}
+
+// sign signs a body with the next link of the chain.
+func (s *ChatSession) sign(body Body) (packets.MessageSignature, error) {
+	link := Link{Index: s.nextIndex, Sender: s.Player, SessionID: s.ID}
+	sig, err := Sign(s.Keys.PrivateKey, s.Rand, link, body)
+	if err != nil {
+		return sig, err
+	}
+	s.nextIndex++
+	return sig, nil
+}
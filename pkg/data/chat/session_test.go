@@ -0,0 +1,113 @@
+package chat_test
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/go-mclib/data/pkg/data/chat"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newChatSession(t *testing.T) *chat.ChatSession {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&testKey(t).PublicKey)
+	require.NoError(t, err)
+	s, err := chat.NewChatSession(bob, chat.ProfileKeys{
+		PrivateKey: testKey(t), PublicKey: der, ExpiresAt: 1_800_000_000_000, KeySignature: []byte{1},
+	})
+	require.NoError(t, err)
+	s.Now = func() time.Time { return time.UnixMilli(1_700_000_000_000) }
+	return s
+}
+
+func TestChatSession(t *testing.T) {
+	s := newChatSession(t)
+	assert.Equal(t, byte(0x40), s.ID[6]&0xf0)
+
+	// a verifier on the other end sees our messages as a valid chain
+	v := chat.NewVerifier()
+	v.Now = s.Now
+	require.NoError(t, v.ApplyChatSessionUpdate(bob, s.SessionUpdate()))
+
+	srv := &server{t: t}
+	seen := srv.message(alice, 0, "hi bob", nil)
+	assert.Nil(t, s.HandlePlayerChat(seen))
+	assert.Nil(t, s.HandlePlayerChat(seen), "repeated signatures are tracked once")
+	assert.Nil(t, s.HandlePlayerChat(&packets.S2CPlayerChat{Sender: alice}), "unsigned messages are not tracked")
+
+	msg := must(s.Chat("hi alice"))
+	assert.Equal(t, ns.VarInt(1), msg.MessageCount)
+	assert.True(t, msg.Acknowledged.Get(chat.LastSeenWindow-1))
+	assert.Equal(t, ns.Int8(chat.Checksum([]packets.MessageSignature{seen.Signature.Value})), msg.Checksum)
+
+	// echoed back by the server with the acknowledged signature as last seen
+	echo := &packets.S2CPlayerChat{
+		GlobalIndex: ns.VarInt(srv.globalIndex),
+		Sender:      bob,
+		Signature:   ns.Some(packets.MessageSignature(msg.Signature.Value)),
+		Body: packets.SignedMessageBody{
+			Content:   msg.Message,
+			Timestamp: msg.Timestamp,
+			Salt:      msg.Salt,
+			LastSeen:  packets.LastSeenMessagesPacked{Entries: []packets.MessageSignaturePacked{srv.cache.Pack(seen.Signature.Value)}},
+		},
+	}
+	v.Verify(seen) // alice has no session here, but her signature gets cached
+	res := v.Verify(echo)
+	require.NoError(t, res.Err)
+	assert.Equal(t, chat.StatusValid, res.Status)
+	srv.globalIndex++
+
+	// each signed argument takes a link of the chain
+	cmd := must(s.Command("msg alice hello there", chat.SignedArgument{Name: "message", Value: "hello there"}))
+	assert.Equal(t, ns.VarInt(0), cmd.MessageCount)
+	require.Len(t, cmd.ArgumentSignatures, 1)
+	assert.Equal(t, ns.String("message"), cmd.ArgumentSignatures[0].Name)
+	link := chat.Link{Index: 1, Sender: bob, SessionID: s.ID}
+	body := chat.Body{Content: "hello there", Timestamp: int64(cmd.Timestamp), Salt: int64(cmd.Salt), LastSeen: []packets.MessageSignature{seen.Signature.Value}}
+	assert.NoError(t, chat.Verify(&testKey(t).PublicKey, cmd.ArgumentSignatures[0].Signature, link, body))
+}
+
+func TestLastSeenTracker(t *testing.T) {
+	s := newChatSession(t)
+	var ack *packets.C2SChatAck
+	for i := range 65 {
+		ack = s.HandlePlayerChat(&packets.S2CPlayerChat{Signature: ns.Some(packets.MessageSignature{0: byte(i), 1: 1})})
+		if i < 64 {
+			require.Nil(t, ack)
+		}
+	}
+	require.NotNil(t, ack)
+	assert.Equal(t, ns.VarInt(65), ack.MessageCount)
+	assert.Nil(t, s.Acknowledge())
+
+	// the window holds the last 20 messages, oldest first
+	var tracker chat.LastSeenTracker
+	for i := range 25 {
+		tracker.Add(packets.MessageSignature{0: byte(i)}, i != 24)
+	}
+	tracker.Ignore(packets.MessageSignature{0: 23})
+	u := tracker.Update()
+	assert.Equal(t, int32(25), u.Offset)
+	require.Len(t, u.LastSeen, 18)
+	assert.Equal(t, byte(5), u.LastSeen[0][0])
+	assert.True(t, u.Acknowledged.Get(0))
+	assert.False(t, u.Acknowledged.Get(18))
+	assert.False(t, u.Acknowledged.Get(19))
+
+	// acknowledged messages stay in the window and can no longer be ignored
+	tracker.Ignore(packets.MessageSignature{0: 5})
+	assert.Equal(t, u.LastSeen, tracker.Update().LastSeen)
+	assert.Equal(t, int8(1), chat.Checksum(nil))
+}
+
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
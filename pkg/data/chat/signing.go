@@ -0,0 +1,178 @@
+// Package chat verifies and produces signed player chat: the message chain of
+// each chat session, the last-seen message window and the signature cache that
+// both sides use to pack last-seen signatures. It also decorates received
+// messages with their chat type.
+package chat
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// signatureVersion is the version prefix of signed chat payloads.
+const signatureVersion = 1
+
+// Link places a message in the chain of a chat session: the index counts the
+// messages (and signed command arguments) the sender signed in the session.
+type Link struct {
+	Index     int32
+	Sender    ns.UUID
+	SessionID ns.UUID
+}
+
+// IsDescendantOf reports whether the link follows prev in the same chain.
+func (l Link) IsDescendantOf(prev Link) bool {
+	return l.Index > prev.Index && l.Sender == prev.Sender && l.SessionID == prev.SessionID
+}
+
+// Body is the signed part of a message.
+type Body struct {
+	Content string
+	// Timestamp is in epoch milliseconds; only whole seconds are signed.
+	Timestamp int64
+	Salt      int64
+	// LastSeen are the signatures of the messages the sender had seen, oldest first.
+	LastSeen []packets.MessageSignature
+}
+
+// SignedPayload returns the bytes a message signature covers.
+func SignedPayload(link Link, body Body) []byte {
+	var b bytes.Buffer
+	write := func(v any) { _ = binary.Write(&b, binary.BigEndian, v) }
+	write(int32(signatureVersion))
+	b.Write(link.Sender[:])
+	b.Write(link.SessionID[:])
+	write(link.Index)
+	write(body.Salt)
+	write(floorDiv(body.Timestamp, 1000))
+	write(int32(len(body.Content)))
+	b.WriteString(body.Content)
+	write(int32(len(body.LastSeen)))
+	for _, sig := range body.LastSeen {
+		b.Write(sig[:])
+	}
+	return b.Bytes()
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// Sign signs a message with SHA256withRSA.
+func Sign(key *rsa.PrivateKey, random io.Reader, link Link, body Body) (packets.MessageSignature, error) {
+	var sig packets.MessageSignature
+	digest := sha256.Sum256(SignedPayload(link, body))
+	signed, err := rsa.SignPKCS1v15(random, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return sig, err
+	}
+	if len(signed) != len(sig) {
+		return sig, fmt.Errorf("signature is %d bytes, want %d (use a 2048-bit key)", len(signed), len(sig))
+	}
+	copy(sig[:], signed)
+	return sig, nil
+}
+
+// Verify checks the SHA256withRSA signature of a message.
+func Verify(key *rsa.PublicKey, sig packets.MessageSignature, link Link, body Body) error {
+	digest := sha256.Sum256(SignedPayload(link, body))
+	return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig[:])
+}
+
+// ParsePublicKey parses the X.509 encoded RSA key of a chat session.
+func ParsePublicKey(der []byte) (*rsa.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("chat session key is a %T, not RSA", key)
+	}
+	return rsaKey, nil
+}
+
+// SignatureCacheSize is the number of signatures a SignatureCache holds.
+const SignatureCacheSize = 128
+
+// SignatureCache holds recent message signatures, so that the last-seen lists of
+// later messages can refer to them by index. The server keeps one per connection
+// and the client mirrors it by pushing every player chat message it receives.
+type SignatureCache struct {
+	entries [SignatureCacheSize]*packets.MessageSignature
+}
+
+// Push adds the last-seen signatures and the signature of a message, most recent
+// first, moving the older entries back.
+func (c *SignatureCache) Push(lastSeen []packets.MessageSignature, sig *packets.MessageSignature) {
+	queue := make([]packets.MessageSignature, 0, len(lastSeen)+1)
+	queue = append(queue, lastSeen...)
+	if sig != nil {
+		queue = append(queue, *sig)
+	}
+	pushed := make(map[packets.MessageSignature]bool, len(queue))
+	for _, s := range queue {
+		pushed[s] = true
+	}
+	for i := 0; len(queue) > 0 && i < len(c.entries); i++ {
+		old := c.entries[i]
+		last := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		c.entries[i] = &last
+		if old != nil && !pushed[*old] {
+			queue = append([]packets.MessageSignature{*old}, queue...)
+		}
+	}
+}
+
+// Pack returns the packed form of a signature: its cache index, or the full
+// signature if it is not cached.
+func (c *SignatureCache) Pack(sig packets.MessageSignature) packets.MessageSignaturePacked {
+	for i, e := range c.entries {
+		if e != nil && *e == sig {
+			return packets.MessageSignaturePacked{ID: ns.VarInt(i + 1)}
+		}
+	}
+	return packets.MessageSignaturePacked{FullSignature: &sig}
+}
+
+// Unpack resolves a packed signature.
+func (c *SignatureCache) Unpack(p packets.MessageSignaturePacked) (packets.MessageSignature, error) {
+	if p.ID == 0 {
+		if p.FullSignature == nil {
+			return packets.MessageSignature{}, fmt.Errorf("packed signature has neither an index nor a signature")
+		}
+		return *p.FullSignature, nil
+	}
+	i := int(p.ID) - 1
+	if i < 0 || i >= len(c.entries) || c.entries[i] == nil {
+		return packets.MessageSignature{}, fmt.Errorf("no cached signature at index %d", i)
+	}
+	return *c.entries[i], nil
+}
+
+// UnpackLastSeen resolves the last-seen list of a message.
+func (c *SignatureCache) UnpackLastSeen(packed packets.LastSeenMessagesPacked) ([]packets.MessageSignature, error) {
+	sigs := make([]packets.MessageSignature, len(packed.Entries))
+	for i, p := range packed.Entries {
+		var err error
+		if sigs[i], err = c.Unpack(p); err != nil {
+			return nil, err
+		}
+	}
+	return sigs, nil
+}
@@ -0,0 +1,227 @@
+package chat
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Status is the outcome of verifying a player chat message.
+type Status int
+
+const (
+	// StatusValid means the message is signed by the sender's session key and
+	// continues the sender's chain.
+	StatusValid Status = iota
+	// StatusUnsigned means the message has no signature.
+	StatusUnsigned
+	// StatusNoSession means the message is signed but the sender has no known chat
+	// session, so it can't be verified.
+	StatusNoSession
+	// StatusExpiredKey means the sender's session key has expired.
+	StatusExpiredKey
+	// StatusTampered means the signature doesn't match the message.
+	StatusTampered
+	// StatusOutOfOrder means the message doesn't follow the previous one: its link
+	// index didn't increase, or the server's global chat index skipped or repeated.
+	StatusOutOfOrder
+	// StatusBrokenChain means an earlier message of the session failed
+	// verification, so the rest of the chain can't be trusted.
+	StatusBrokenChain
+	// StatusBadLastSeen means the last-seen signatures couldn't be unpacked.
+	StatusBadLastSeen
+)
+
+var statusNames = [...]string{"valid", "unsigned", "no session", "expired key", "tampered", "out of order", "broken chain", "bad last seen"}
+
+func (s Status) String() string {
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+	return statusNames[s]
+}
+
+// Result is the result of verifying a player chat message.
+type Result struct {
+	Status Status
+	// Err describes the failure, or is nil for valid and unsigned messages.
+	Err error
+	// Link and Body are the message as it was signed.
+	Link Link
+	Body Body
+}
+
+// Session is a player's chat session.
+type Session struct {
+	ID        ns.UUID
+	PublicKey *rsa.PublicKey
+	// ExpiresAt is the key expiry in epoch milliseconds.
+	ExpiresAt int64
+
+	last        *Link
+	brokenChain bool
+}
+
+// Expired reports whether the session key has expired at the given time.
+func (s *Session) Expired(now time.Time) bool {
+	return s.ExpiresAt < now.UnixMilli()
+}
+
+// Verifier checks the signatures and chain of the player chat messages received
+// on one client connection.
+type Verifier struct {
+	// Now returns the current time for key expiry checks; defaults to time.Now.
+	Now func() time.Time
+	// VerifyKey, if set, is called for each new session, e.g. to check the key
+	// signature with the Mojang services key. Sessions it rejects are dropped.
+	VerifyKey func(player ns.UUID, s *packets.RemoteChatSession) error
+
+	sessions    map[ns.UUID]*Session
+	cache       SignatureCache
+	globalIndex int32
+}
+
+// NewVerifier returns a verifier without sessions.
+func NewVerifier() *Verifier {
+	return &Verifier{Now: time.Now, sessions: make(map[ns.UUID]*Session)}
+}
+
+// Session returns the chat session of a player, or nil.
+func (v *Verifier) Session(player ns.UUID) *Session {
+	return v.sessions[player]
+}
+
+// SetSession starts a new chat session for a player, resetting its chain.
+func (v *Verifier) SetSession(player ns.UUID, s *packets.RemoteChatSession) error {
+	if v.VerifyKey != nil {
+		if err := v.VerifyKey(player, s); err != nil {
+			delete(v.sessions, player)
+			return err
+		}
+	}
+	key, err := ParsePublicKey(s.PublicKey)
+	if err != nil {
+		delete(v.sessions, player)
+		return err
+	}
+	v.sessions[player] = &Session{ID: s.SessionId, PublicKey: key, ExpiresAt: int64(s.ExpiresAt)}
+	return nil
+}
+
+// ApplyChatSessionUpdate starts the session a player sent to the server, e.g.
+// when watching a connection through a proxy.
+func (v *Verifier) ApplyChatSessionUpdate(player ns.UUID, p *packets.C2SChatSessionUpdate) error {
+	return v.SetSession(player, &packets.RemoteChatSession{
+		SessionId:    p.SessionId,
+		ExpiresAt:    p.ExpiresAt,
+		PublicKey:    p.PublicKey,
+		KeySignature: p.KeySignature,
+	})
+}
+
+// ApplyPlayerInfoUpdate starts or clears the sessions of the initialize chat
+// action. It returns the first error, after applying all entries.
+func (v *Verifier) ApplyPlayerInfoUpdate(p *packets.S2CPlayerInfoUpdate) error {
+	if !p.Actions.Has(packets.PlayerInfoInitializeChat) {
+		return nil
+	}
+	var firstErr error
+	for i := range p.Entries {
+		e := &p.Entries[i]
+		if !e.ChatSession.Present {
+			delete(v.sessions, e.Uuid)
+			continue
+		}
+		if err := v.SetSession(e.Uuid, &e.ChatSession.Value); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("player %s: %w", e.Uuid, err)
+		}
+	}
+	return firstErr
+}
+
+// ApplyPlayerInfoRemove drops the sessions of the removed players.
+func (v *Verifier) ApplyPlayerInfoRemove(p *packets.S2CPlayerInfoRemove) {
+	for _, id := range p.Uuids {
+		delete(v.sessions, id)
+	}
+}
+
+// Verify checks a player chat message and records its signature, so later
+// messages can refer to it in their last-seen lists. Messages must be passed in
+// the order they were received.
+func (v *Verifier) Verify(p *packets.S2CPlayerChat) Result {
+	res := v.verify(p)
+	var sig *packets.MessageSignature
+	if p.Signature.Present {
+		sig = &p.Signature.Value
+	}
+	v.cache.Push(res.Body.LastSeen, sig)
+	return res
+}
+
+func (v *Verifier) verify(p *packets.S2CPlayerChat) Result {
+	res := Result{
+		Link: Link{Index: int32(p.Index), Sender: p.Sender},
+		Body: Body{Content: string(p.Body.Content), Timestamp: int64(p.Body.Timestamp), Salt: int64(p.Body.Salt)},
+	}
+	global := int32(p.GlobalIndex)
+	expected := v.globalIndex
+	v.globalIndex = global + 1
+	lastSeen, err := v.cache.UnpackLastSeen(p.Body.LastSeen)
+	if err != nil {
+		return res.fail(StatusBadLastSeen, err)
+	}
+	res.Body.LastSeen = lastSeen
+	if global != expected {
+		return res.fail(StatusOutOfOrder, fmt.Errorf("expected global chat index %d, got %d", expected, global))
+	}
+
+	session := v.sessions[p.Sender]
+	if !p.Signature.Present {
+		if session != nil {
+			// an unsigned message from a player with a session breaks the chain
+			session.brokenChain = true
+			session.last = nil
+		}
+		res.Status = StatusUnsigned
+		return res
+	}
+	if session == nil {
+		return res.fail(StatusNoSession, fmt.Errorf("no chat session for %s", p.Sender))
+	}
+	res.Link.SessionID = session.ID
+	if session.brokenChain {
+		return res.fail(StatusBrokenChain, fmt.Errorf("chat chain of %s is broken", p.Sender))
+	}
+
+	status, err := session.check(res.Link, res.Body, p.Signature.Value, v.Now())
+	if err != nil {
+		session.brokenChain = true
+		session.last = nil
+		return res.fail(status, err)
+	}
+	session.last = &res.Link
+	return res
+}
+
+func (s *Session) check(link Link, body Body, sig packets.MessageSignature, now time.Time) (Status, error) {
+	if s.last != nil && !link.IsDescendantOf(*s.last) {
+		return StatusOutOfOrder, fmt.Errorf("message index %d does not follow %d", link.Index, s.last.Index)
+	}
+	if s.Expired(now) {
+		return StatusExpiredKey, fmt.Errorf("chat session key expired at %s", time.UnixMilli(s.ExpiresAt).UTC())
+	}
+	if err := Verify(s.PublicKey, sig, link, body); err != nil {
+		return StatusTampered, fmt.Errorf("invalid signature: %w", err)
+	}
+	return StatusValid, nil
+}
+
+func (r Result) fail(status Status, err error) Result {
+	r.Status, r.Err = status, err
+	return r
+}
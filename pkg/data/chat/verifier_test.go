@@ -0,0 +1,190 @@
+package chat_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-mclib/data/pkg/data/chat"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice   = ns.UUID{0: 0xa1}
+	bob     = ns.UUID{0: 0xb0}
+	session = ns.UUID{0: 0x5e, 15: 1}
+
+	keyOnce sync.Once
+	key     *rsa.PrivateKey
+)
+
+// testKey returns a 2048-bit key shared by the tests, as generating one is slow.
+func testKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	keyOnce.Do(func() {
+		var err error
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+	})
+	return key
+}
+
+func remoteSession(t *testing.T, id ns.UUID, expiresAt time.Time) packets.RemoteChatSession {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(&testKey(t).PublicKey)
+	require.NoError(t, err)
+	return packets.RemoteChatSession{SessionId: id, ExpiresAt: ns.Int64(expiresAt.UnixMilli()), PublicKey: der, KeySignature: []byte{1}}
+}
+
+// server signs messages and packs their last-seen lists like a vanilla server
+// sending to one client.
+type server struct {
+	t           *testing.T
+	cache       chat.SignatureCache
+	globalIndex int32
+}
+
+func (s *server) message(sender ns.UUID, index int32, content string, lastSeen []packets.MessageSignature) *packets.S2CPlayerChat {
+	link := chat.Link{Index: index, Sender: sender, SessionID: session}
+	body := chat.Body{Content: content, Timestamp: 1_700_000_000_123, Salt: 42, LastSeen: lastSeen}
+	sig, err := chat.Sign(testKey(s.t), rand.Reader, link, body)
+	require.NoError(s.t, err)
+	p := &packets.S2CPlayerChat{
+		GlobalIndex: ns.VarInt(s.globalIndex),
+		Sender:      sender,
+		Index:       ns.VarInt(index),
+		Signature:   ns.Some(sig),
+		Body: packets.SignedMessageBody{
+			Content:   ns.String(content),
+			Timestamp: ns.Int64(body.Timestamp),
+			Salt:      ns.Int64(body.Salt),
+		},
+	}
+	for _, seen := range lastSeen {
+		p.Body.LastSeen.Entries = append(p.Body.LastSeen.Entries, s.cache.Pack(seen))
+	}
+	s.cache.Push(lastSeen, &sig)
+	s.globalIndex++
+	return p
+}
+
+func TestVerifier(t *testing.T) {
+	v := chat.NewVerifier()
+	v.Now = func() time.Time { return time.UnixMilli(1_700_000_000_000) }
+	remote := remoteSession(t, session, time.UnixMilli(1_800_000_000_000))
+	require.NoError(t, v.ApplyPlayerInfoUpdate(&packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoAddPlayer | packets.PlayerInfoInitializeChat,
+		Entries: []packets.PlayerInfoEntry{{Uuid: alice, Name: "alice", ChatSession: ns.Some(remote)}},
+	}))
+	require.NotNil(t, v.Session(alice))
+
+	srv := &server{t: t}
+	first := srv.message(alice, 0, "hello", nil)
+	res := v.Verify(first)
+	require.NoError(t, res.Err)
+	assert.Equal(t, chat.StatusValid, res.Status)
+
+	// the last-seen list refers to the first message by cache index
+	second := srv.message(alice, 1, "héllo again", []packets.MessageSignature{first.Signature.Value})
+	require.Equal(t, ns.VarInt(1), second.Body.LastSeen.Entries[0].ID)
+	res = v.Verify(second)
+	require.NoError(t, res.Err)
+	assert.Equal(t, []packets.MessageSignature{first.Signature.Value}, res.Body.LastSeen)
+
+	// unsigned messages from players without a session are fine
+	unsigned := &packets.S2CPlayerChat{GlobalIndex: ns.VarInt(srv.globalIndex), Sender: bob, Body: packets.SignedMessageBody{Content: "hi"}}
+	srv.globalIndex++
+	assert.Equal(t, chat.StatusUnsigned, v.Verify(unsigned).Status)
+
+	// tampering breaks the chain until the next session
+	tampered := srv.message(alice, 2, "original", nil)
+	tampered.Body.Content = "edited"
+	res = v.Verify(tampered)
+	assert.Equal(t, chat.StatusTampered, res.Status)
+	assert.Error(t, res.Err)
+	assert.Equal(t, chat.StatusBrokenChain, v.Verify(srv.message(alice, 3, "next", nil)).Status)
+
+	require.NoError(t, v.ApplyChatSessionUpdate(alice, &packets.C2SChatSessionUpdate{
+		SessionId: remote.SessionId, ExpiresAt: remote.ExpiresAt, PublicKey: remote.PublicKey, KeySignature: remote.KeySignature,
+	}))
+	assert.Equal(t, chat.StatusValid, v.Verify(srv.message(alice, 5, "fresh session", nil)).Status)
+	assert.Equal(t, chat.StatusOutOfOrder, v.Verify(srv.message(alice, 5, "replayed index", nil)).Status)
+}
+
+func TestVerifierFailures(t *testing.T) {
+	v := chat.NewVerifier()
+	v.Now = func() time.Time { return time.UnixMilli(1_700_000_000_000) }
+	srv := &server{t: t}
+
+	assert.Equal(t, chat.StatusNoSession, v.Verify(srv.message(bob, 0, "who am i", nil)).Status)
+
+	expired := remoteSession(t, session, time.UnixMilli(1_600_000_000_000))
+	require.NoError(t, v.SetSession(bob, &expired))
+	assert.Equal(t, chat.StatusExpiredKey, v.Verify(srv.message(bob, 1, "old key", nil)).Status)
+
+	// the server skipped a message
+	srv.globalIndex++
+	res := v.Verify(srv.message(bob, 2, "gap", nil))
+	assert.Equal(t, chat.StatusOutOfOrder, res.Status)
+	assert.Equal(t, "out of order", res.Status.String())
+
+	// a cache index the client never saw
+	p := srv.message(bob, 3, "unknown last seen", nil)
+	p.Body.LastSeen.Entries = append(p.Body.LastSeen.Entries, packets.MessageSignaturePacked{ID: 100})
+	assert.Equal(t, chat.StatusBadLastSeen, v.Verify(p).Status)
+
+	v.ApplyPlayerInfoRemove(&packets.S2CPlayerInfoRemove{Uuids: []ns.UUID{bob}})
+	assert.Nil(t, v.Session(bob))
+
+	bad := packets.RemoteChatSession{SessionId: session, PublicKey: []byte("not a key")}
+	assert.Error(t, v.SetSession(bob, &bad))
+	assert.Nil(t, v.Session(bob))
+}
+
+func TestSignatureCache(t *testing.T) {
+	var c chat.SignatureCache
+	sig := func(b byte) packets.MessageSignature { return packets.MessageSignature{0: b} }
+
+	a, b := sig(1), sig(2)
+	c.Push(nil, &a)
+	c.Push([]packets.MessageSignature{a}, &b)
+	// most recent first
+	assert.Equal(t, ns.VarInt(1), c.Pack(b).ID)
+	assert.Equal(t, ns.VarInt(2), c.Pack(a).ID)
+
+	packed := c.Pack(sig(3))
+	assert.Equal(t, ns.VarInt(0), packed.ID)
+	unpacked, err := c.Unpack(packed)
+	require.NoError(t, err)
+	assert.Equal(t, sig(3), unpacked)
+
+	// old entries fall out after SignatureCacheSize pushes
+	for i := range chat.SignatureCacheSize {
+		s := packets.MessageSignature{0: 0xff, 1: byte(i)}
+		c.Push(nil, &s)
+	}
+	assert.Equal(t, ns.VarInt(0), c.Pack(a).ID)
+}
+
+func TestSignedPayload(t *testing.T) {
+	payload := chat.SignedPayload(
+		chat.Link{Index: 7, Sender: alice, SessionID: session},
+		chat.Body{Content: "hé", Timestamp: -1500, Salt: 2, LastSeen: []packets.MessageSignature{{0: 9}}},
+	)
+	expected := []byte{0, 0, 0, 1}
+	expected = append(expected, alice[:]...)
+	expected = append(expected, session[:]...)
+	expected = append(expected, 0, 0, 0, 7)
+	expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 2)
+	expected = append(expected, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe) // seconds, rounded down
+	expected = append(expected, 0, 0, 0, 3, 'h', 0xc3, 0xa9)
+	expected = append(expected, 0, 0, 0, 1, 9)
+	expected = append(expected, make([]byte, 255)...)
+	assert.Equal(t, expected, payload)
+}
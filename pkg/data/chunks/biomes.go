@@ -0,0 +1,85 @@
+package chunks
+
+import (
+	"bytes"
+	"fmt"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// DecodeBiomes decodes the biome containers of each section of a chunk column,
+// from the bottom section up, as sent in S2CChunksBiomes.
+func DecodeBiomes(data []byte) ([]*PalettedContainer, error) {
+	r := bytes.NewReader(data)
+	buf := ns.NewReaderFrom(r)
+	var biomes []*PalettedContainer
+	for r.Len() > 0 {
+		biome := &PalettedContainer{kind: BiomesKind}
+		if err := biome.Decode(buf); err != nil {
+			return nil, fmt.Errorf("section %d biomes: %w", len(biomes), err)
+		}
+		biomes = append(biomes, biome)
+	}
+	return biomes, nil
+}
+
+// EncodeBiomes encodes the biome containers of each section of a chunk column.
+func EncodeBiomes(biomes []*PalettedContainer) ([]byte, error) {
+	buf := ns.NewWriter()
+	for _, biome := range biomes {
+		if biome == nil {
+			biome = NewSingleValue(BiomesKind, 0)
+		}
+		if err := biome.Encode(buf); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Biomes returns the biome containers of each section, from the bottom section up.
+// Missing sections have a nil container.
+func (c *ChunkColumn) Biomes() []*PalettedContainer {
+	biomes := make([]*PalettedContainer, SectionCount)
+	for i, sec := range c.Sections {
+		if sec != nil {
+			biomes[i] = sec.Biomes
+		}
+	}
+	return biomes
+}
+
+// ApplyBiomes replaces the biome containers of all sections, keeping the block
+// states, e.g. after a /fillbiome. Creates empty sections where needed.
+func (c *ChunkColumn) ApplyBiomes(biomes []*PalettedContainer) error {
+	if len(biomes) != SectionCount {
+		return fmt.Errorf("got biomes for %d sections, want %d", len(biomes), SectionCount)
+	}
+	for i, biome := range biomes {
+		if biome == nil {
+			return fmt.Errorf("section %d has no biomes", i)
+		}
+		if biome.kind != BiomesKind {
+			return fmt.Errorf("section %d: not a biome container", i)
+		}
+	}
+	for i, biome := range biomes {
+		if c.Sections[i] == nil {
+			c.Sections[i] = NewEmptySection()
+		}
+		c.Sections[i].Biomes = biome
+	}
+	return nil
+}
+
+// GetBiome returns the biome ID at absolute world coordinates, with the 4x4x4
+// block resolution of biome containers. Returns 0 if the coordinates are out of
+// range or the section is nil.
+func (c *ChunkColumn) GetBiome(x, y, z int) int32 {
+	idx := SectionIndex(y)
+	if idx < 0 || c.Sections[idx] == nil {
+		return 0
+	}
+	lx, ly, lz := LocalCoords(x, y, z)
+	return c.Sections[idx].GetBiome(lx>>2, ly>>2, lz>>2)
+}
@@ -216,3 +216,35 @@ func TestDecodeBlockEntry(t *testing.T) {
 	assert.Equal(t, 12, ly)
 	assert.Equal(t, 7, lz)
 }
+
+func TestChunkColumnApplyBiomes(t *testing.T) {
+	col := &chunks.ChunkColumn{X: 2, Z: -3}
+	col.SetBlockState(1, 70, 1, 100)
+
+	biomes := make([]*chunks.PalettedContainer, chunks.SectionCount)
+	for i := range biomes {
+		biomes[i] = chunks.NewSingleValue(chunks.BiomesKind, 1)
+	}
+	biomes[chunks.SectionIndex(70)].SetXYZ(0, 1, 0, 7)
+
+	// encode and decode like S2CChunksBiomes
+	data, err := chunks.EncodeBiomes(biomes)
+	require.NoError(t, err)
+	decoded, err := chunks.DecodeBiomes(data)
+	require.NoError(t, err)
+	require.Len(t, decoded, chunks.SectionCount)
+
+	require.NoError(t, col.ApplyBiomes(decoded))
+	assert.Equal(t, int32(7), col.GetBiome(3, 70, 2))
+	assert.Equal(t, int32(1), col.GetBiome(4, 70, 2))
+	assert.Equal(t, int32(1), col.GetBiome(0, -64, 0))
+	assert.Equal(t, int32(100), col.GetBlockState(1, 70, 1), "block states are kept")
+	assert.Equal(t, decoded, col.Biomes())
+
+	assert.Error(t, col.ApplyBiomes(decoded[:4]))
+	decoded[0] = chunks.NewSingleValue(chunks.BlockStatesKind, 0)
+	assert.Error(t, col.ApplyBiomes(decoded))
+
+	_, err = chunks.DecodeBiomes([]byte{0x01, 0x01})
+	assert.Error(t, err)
+}
@@ -0,0 +1,382 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	rangePattern      = regexp.MustCompile(`^(-?\d*\.?\d*)?(\.\.(-?\d*\.?\d*)?)?$`)
+	uuidPattern       = regexp.MustCompile(`^[0-9a-fA-F]{1,8}-[0-9a-fA-F]{1,4}-[0-9a-fA-F]{1,4}-[0-9a-fA-F]{1,4}-[0-9a-fA-F]{1,12}$`)
+	hexColorPattern   = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+	identifierPattern = regexp.MustCompile(`^([a-z0-9_.-]+:)?[a-z0-9_./-]+$`)
+)
+
+// enumerated values of the keyword-like argument parsers
+var argumentKeywords = map[string][]string{
+	"minecraft:color": {"black", "dark_blue", "dark_green", "dark_aqua", "dark_red", "dark_purple", "gold", "gray",
+		"dark_gray", "blue", "green", "aqua", "red", "light_purple", "yellow", "white", "reset"},
+	"minecraft:gamemode":          {"survival", "creative", "adventure", "spectator"},
+	"minecraft:heightmap":         {"world_surface", "motion_blocking", "motion_blocking_no_leaves", "ocean_floor"},
+	"minecraft:entity_anchor":     {"feet", "eyes"},
+	"minecraft:template_mirror":   {"none", "left_right", "front_back"},
+	"minecraft:template_rotation": {"none", "clockwise_90", "180", "counterclockwise_90"},
+	"minecraft:operation":         {"=", "+=", "-=", "*=", "/=", "%=", "<", ">", "><"},
+}
+
+// readWord returns the input up to the next space.
+func readWord(s string) string {
+	if i := strings.IndexByte(s, ' '); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// isUnquotedChar reports whether c may appear in an unquoted Brigadier string.
+func isUnquotedChar(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' ||
+		c == '_' || c == '-' || c == '.' || c == '+'
+}
+
+func readUnquoted(s string) string {
+	i := 0
+	for i < len(s) && isUnquotedChar(s[i]) {
+		i++
+	}
+	return s[:i]
+}
+
+// readQuoted returns the length of the quoted string at the start of s, including quotes.
+func readQuoted(s string) (int, error) {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 >= len(s) || s[i+1] != quote && s[i+1] != '\\' {
+				return 0, errors.New("invalid escape sequence in quoted string")
+			}
+			i++
+		case quote:
+			return i + 1, nil
+		}
+	}
+	return 0, errors.New("unclosed quoted string")
+}
+
+// readToken returns the length of the token at the start of s: everything up to the
+// next space outside of brackets and quotes (for SNBT, JSON, item and block arguments).
+func readToken(s string) (int, error) {
+	var stack []byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case ' ':
+			if len(stack) == 0 {
+				return i, nil
+			}
+		case '"', '\'':
+			n, err := readQuoted(s[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n - 1
+		case '{', '[', '(':
+			stack = append(stack, map[byte]byte{'{': '}', '[': ']', '(': ')'}[c])
+		case '}', ']', ')':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return 0, fmt.Errorf("unexpected %q", c)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if len(stack) > 0 {
+		return 0, fmt.Errorf("expected %q", stack[len(stack)-1])
+	}
+	return len(s), nil
+}
+
+// parseArgument checks that input[cursor:] starts with a valid value for the parser
+// and returns the offset just past it. Structured arguments (NBT, components, block
+// states, ...) are only checked for balanced brackets and quotes.
+func parseArgument(p *Parser, input string, cursor int) (int, error) {
+	s := input[cursor:]
+	n, err := argumentLength(p, s)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("expected %s", p.ID)
+	}
+	return cursor + n, nil
+}
+
+func argumentLength(p *Parser, s string) (int, error) {
+	word := readWord(s)
+	switch p.ID {
+	case "brigadier:bool":
+		v := readUnquoted(s)
+		if v != "true" && v != "false" {
+			return 0, fmt.Errorf("invalid boolean, expected true or false but found %q", v)
+		}
+		return len(v), nil
+	case "brigadier:integer", "brigadier:long", "brigadier:float", "brigadier:double":
+		return parseNumber(p, s)
+	case "brigadier:string":
+		props, _ := p.Properties.(*StringProperties)
+		if props != nil && props.Behavior == StringGreedyPhrase {
+			return len(s), nil
+		}
+		if props != nil && props.Behavior == StringQuotablePhrase && (s[0] == '"' || s[0] == '\'') {
+			return readQuoted(s)
+		}
+		return len(readUnquoted(s)), nil
+	case "minecraft:message":
+		return len(s), nil
+	case "minecraft:entity", "minecraft:game_profile", "minecraft:score_holder":
+		return parseEntity(p, s)
+	case "minecraft:block_pos":
+		return parseCoordinates(s, 3, true)
+	case "minecraft:vec3":
+		return parseCoordinates(s, 3, false)
+	case "minecraft:column_pos":
+		return parseCoordinates(s, 2, true)
+	case "minecraft:vec2", "minecraft:rotation":
+		return parseCoordinates(s, 2, false)
+	case "minecraft:angle":
+		return parseCoordinates(s, 1, false)
+	case "minecraft:time":
+		return parseTime(p, word)
+	case "minecraft:uuid":
+		if !uuidPattern.MatchString(word) {
+			return 0, fmt.Errorf("invalid UUID %q", word)
+		}
+		return len(word), nil
+	case "minecraft:hex_color":
+		if !hexColorPattern.MatchString(word) {
+			return 0, fmt.Errorf("invalid hex color %q", word)
+		}
+		return len(word), nil
+	case "minecraft:int_range", "minecraft:float_range":
+		if word == ".." || !rangePattern.MatchString(word) {
+			return 0, fmt.Errorf("invalid range %q", word)
+		}
+		return len(word), nil
+	case "minecraft:swizzle":
+		for i, c := range word {
+			if !strings.ContainsRune("xyz", c) || strings.ContainsRune(word[:i], c) {
+				return 0, fmt.Errorf("invalid swizzle %q, expected combination of 'x', 'y' and 'z'", word)
+			}
+		}
+		return len(word), nil
+	case "minecraft:scoreboard_slot":
+		if word == "list" || word == "sidebar" || word == "below_name" {
+			return len(word), nil
+		}
+		if color, ok := strings.CutPrefix(word, "sidebar.team."); ok &&
+			color != "reset" && slices.Contains(argumentKeywords["minecraft:color"], color) {
+			return len(word), nil
+		}
+		return 0, fmt.Errorf("unknown display slot %q", word)
+	case "minecraft:resource_location", "minecraft:dimension", "minecraft:resource", "minecraft:resource_key":
+		if !identifierPattern.MatchString(word) {
+			return 0, fmt.Errorf("invalid identifier %q", word)
+		}
+		return len(word), nil
+	case "minecraft:function", "minecraft:resource_or_tag", "minecraft:resource_or_tag_key":
+		if !identifierPattern.MatchString(strings.TrimPrefix(word, "#")) {
+			return 0, fmt.Errorf("invalid identifier %q", word)
+		}
+		return len(word), nil
+	}
+	if keywords, ok := argumentKeywords[p.ID]; ok {
+		if !slices.Contains(keywords, word) {
+			return 0, fmt.Errorf("invalid %s %q", strings.TrimPrefix(p.ID, "minecraft:"), word)
+		}
+		return len(word), nil
+	}
+	return readToken(s)
+}
+
+func parseNumber(p *Parser, s string) (int, error) {
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '-' || s[i] == '.') {
+		i++
+	}
+	num := s[:i]
+	if num == "" {
+		return 0, fmt.Errorf("expected %s", strings.TrimPrefix(p.ID, "brigadier:"))
+	}
+	outOfBounds := func(kind string, v, lo, hi float64, hasLo, hasHi bool) error {
+		if hasLo && v < lo {
+			return fmt.Errorf("%s must not be less than %v, found %v", kind, lo, v)
+		}
+		if hasHi && v > hi {
+			return fmt.Errorf("%s must not be more than %v, found %v", kind, hi, v)
+		}
+		return nil
+	}
+
+	switch props := p.Properties.(type) {
+	case *IntegerProperties:
+		v, err := strconv.ParseInt(num, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer %q", num)
+		}
+		if props.Min != nil && int32(v) < *props.Min {
+			return 0, fmt.Errorf("integer must not be less than %d, found %d", *props.Min, v)
+		}
+		if props.Max != nil && int32(v) > *props.Max {
+			return 0, fmt.Errorf("integer must not be more than %d, found %d", *props.Max, v)
+		}
+	case *LongProperties:
+		v, err := strconv.ParseInt(num, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid long %q", num)
+		}
+		if props.Min != nil && v < *props.Min {
+			return 0, fmt.Errorf("long must not be less than %d, found %d", *props.Min, v)
+		}
+		if props.Max != nil && v > *props.Max {
+			return 0, fmt.Errorf("long must not be more than %d, found %d", *props.Max, v)
+		}
+	case *FloatProperties:
+		v, err := strconv.ParseFloat(num, 32)
+		if err != nil || math.IsInf(v, 0) {
+			return 0, fmt.Errorf("invalid float %q", num)
+		}
+		var lo, hi float64
+		if props.Min != nil {
+			lo = float64(*props.Min)
+		}
+		if props.Max != nil {
+			hi = float64(*props.Max)
+		}
+		if err := outOfBounds("float", v, lo, hi, props.Min != nil, props.Max != nil); err != nil {
+			return 0, err
+		}
+	case *DoubleProperties:
+		v, err := strconv.ParseFloat(num, 64)
+		if err != nil || math.IsInf(v, 0) {
+			return 0, fmt.Errorf("invalid double %q", num)
+		}
+		var lo, hi float64
+		if props.Min != nil {
+			lo = *props.Min
+		}
+		if props.Max != nil {
+			hi = *props.Max
+		}
+		if err := outOfBounds("double", v, lo, hi, props.Min != nil, props.Max != nil); err != nil {
+			return 0, err
+		}
+	default:
+		if _, err := strconv.ParseFloat(num, 64); err != nil {
+			return 0, fmt.Errorf("invalid number %q", num)
+		}
+	}
+	return i, nil
+}
+
+// parseEntity checks an entity selector, player name or UUID.
+func parseEntity(p *Parser, s string) (int, error) {
+	if p.ID == "minecraft:score_holder" && strings.HasPrefix(s, "*") {
+		return 1, nil
+	}
+	if !strings.HasPrefix(s, "@") {
+		return len(readWord(s)), nil
+	}
+	if len(s) < 2 || !strings.ContainsRune("parsen", rune(s[1])) {
+		return 0, errors.New("unknown selector type")
+	}
+	n := 2
+	if n < len(s) && s[n] == '[' {
+		m, err := readToken(s[n:])
+		if err != nil {
+			return 0, err
+		}
+		if m == 0 || s[n+m-1] != ']' {
+			return 0, errors.New("expected end of selector options")
+		}
+		n += m
+	}
+	args := s[2:n]
+
+	kind := s[1]
+	if props, ok := p.Properties.(*EntityProperties); ok {
+		if props.Single && (kind == 'a' || kind == 'e') && !strings.Contains(args, "limit=1") {
+			return 0, errors.New("only one entity is allowed, but the provided selector allows more than one")
+		}
+		if props.PlayersOnly && (kind == 'e' || kind == 'n') &&
+			!strings.Contains(args, "type=player") && !strings.Contains(args, "type=minecraft:player") {
+			return 0, errors.New("only players may be affected by this command, but the provided selector includes entities")
+		}
+	}
+	return n, nil
+}
+
+// parseCoordinates checks count space-separated absolute (1), relative (~1) or
+// local (^1) coordinates. Absolute block coordinates must be integers.
+func parseCoordinates(s string, count int, integer bool) (int, error) {
+	n := 0
+	local := false
+	for i := range count {
+		if i > 0 {
+			if n >= len(s) || s[n] != ' ' {
+				return 0, errors.New("incomplete coordinates")
+			}
+			n++
+		}
+		coord := readWord(s[n:])
+		if coord == "" {
+			return 0, errors.New("incomplete coordinates")
+		}
+		isLocal := coord[0] == '^'
+		if i > 0 && isLocal != local {
+			return 0, errors.New("cannot mix world & local coordinates")
+		}
+		local = isLocal
+
+		num := coord
+		relative := coord[0] == '~' || coord[0] == '^'
+		if relative {
+			num = coord[1:]
+		}
+		if num != "" {
+			if integer && !relative {
+				if _, err := strconv.ParseInt(num, 10, 32); err != nil {
+					return 0, fmt.Errorf("invalid integer coordinate %q", coord)
+				}
+			} else if _, err := strconv.ParseFloat(num, 64); err != nil {
+				return 0, fmt.Errorf("invalid coordinate %q", coord)
+			}
+		}
+		n += len(coord)
+	}
+	return n, nil
+}
+
+// parseTime checks a duration with an optional unit (d, s or t).
+func parseTime(p *Parser, word string) (int, error) {
+	num, scale := word, 1.0
+	switch {
+	case strings.HasSuffix(word, "d"):
+		num, scale = word[:len(word)-1], 24000
+	case strings.HasSuffix(word, "s"):
+		num, scale = word[:len(word)-1], 20
+	case strings.HasSuffix(word, "t"):
+		num = word[:len(word)-1]
+	}
+	v, err := strconv.ParseFloat(num, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", word)
+	}
+	ticks := int32(math.Round(v * scale))
+	if props, ok := p.Properties.(*TimeProperties); ok && ticks < props.Min {
+		return 0, fmt.Errorf("tick count must not be less than %d, found %d", props.Min, ticks)
+	}
+	return len(word), nil
+}
@@ -0,0 +1,191 @@
+package commands_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/commands"
+	"github.com/go-mclib/data/pkg/data/registries"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int32) *int32 { return &v }
+
+// testGraph builds a small vanilla-like graph:
+//
+//	give <targets> <item> [<count>]
+//	execute run -> root
+//	say <message>
+func testGraph() *commands.Graph {
+	return &commands.Graph{
+		Root: 0,
+		Nodes: []commands.Node{
+			{Type: commands.NodeRoot, Children: []int32{1, 5, 7}, Redirect: -1},
+			{Type: commands.NodeLiteral, Name: "give", Children: []int32{2}, Redirect: -1},
+			{Type: commands.NodeArgument, Name: "targets", Children: []int32{3}, Redirect: -1,
+				Parser: &commands.Parser{ID: "minecraft:entity", Properties: &commands.EntityProperties{PlayersOnly: true}}},
+			{Type: commands.NodeArgument, Name: "item", Executable: true, Children: []int32{4}, Redirect: -1,
+				Parser: &commands.Parser{ID: "minecraft:item_stack"}},
+			{Type: commands.NodeArgument, Name: "count", Executable: true, Redirect: -1,
+				Parser: &commands.Parser{ID: "brigadier:integer", Properties: &commands.IntegerProperties{Min: intPtr(1)}}},
+			{Type: commands.NodeLiteral, Name: "execute", Children: []int32{6}, Redirect: -1, Restricted: true},
+			{Type: commands.NodeLiteral, Name: "run", Redirect: 0},
+			{Type: commands.NodeLiteral, Name: "say", Children: []int32{8}, Redirect: -1},
+			{Type: commands.NodeArgument, Name: "message", Executable: true, Redirect: -1,
+				Parser:      &commands.Parser{ID: "brigadier:string", Properties: &commands.StringProperties{Behavior: commands.StringGreedyPhrase}},
+				Suggestions: "minecraft:ask_server"},
+		},
+	}
+}
+
+func TestGraphWireFormat(t *testing.T) {
+	g := &commands.Graph{
+		Root: 0,
+		Nodes: []commands.Node{
+			{Type: commands.NodeRoot, Children: []int32{1}, Redirect: -1},
+			{Type: commands.NodeLiteral, Name: "kill", Children: []int32{2}, Redirect: -1},
+			{Type: commands.NodeArgument, Name: "n", Executable: true, Redirect: -1,
+				Parser: &commands.Parser{ID: "brigadier:integer", Properties: &commands.IntegerProperties{Max: intPtr(5)}}},
+		},
+	}
+	buf := ns.NewWriter()
+	require.NoError(t, g.Write(buf))
+
+	integerID := byte(registries.CommandArgumentType.Get("brigadier:integer"))
+	expected := []byte{
+		0x03,             // node count
+		0x00, 0x01, 0x01, // root: flags, 1 child, child 1
+		0x01, 0x01, 0x02, 0x04, 'k', 'i', 'l', 'l', // literal "kill", 1 child, child 2
+		0x06, 0x00, 0x01, 'n', integerID, 0x02, 0x00, 0x00, 0x00, 0x05, // executable argument "n", max 5
+		0x00, // root index
+	}
+	assert.Equal(t, expected, buf.Bytes())
+}
+
+func TestGraphRoundTrip(t *testing.T) {
+	g := testGraph()
+	buf := ns.NewWriter()
+	require.NoError(t, g.Write(buf))
+	wire := buf.Bytes()
+
+	var decoded commands.Graph
+	require.NoError(t, decoded.Read(ns.NewReader(wire)))
+	assert.Equal(t, *g, decoded)
+
+	reencoded := ns.NewWriter()
+	require.NoError(t, decoded.Write(reencoded))
+	assert.Equal(t, wire, reencoded.Bytes())
+}
+
+func TestGraphReadRejectsBadIndices(t *testing.T) {
+	g := testGraph()
+	g.Nodes[1].Children = []int32{42}
+	assert.Error(t, g.Write(ns.NewWriter()))
+
+	g = testGraph()
+	g.Root = 99
+	assert.Error(t, g.Write(ns.NewWriter()))
+}
+
+func TestGraphUsage(t *testing.T) {
+	g := testGraph()
+	assert.Equal(t, []string{
+		"/give <targets> <item> [<count>]",
+		"/execute run ...",
+		"/say <message>",
+	}, g.Usage())
+	assert.Equal(t, "/give <targets> <item> [<count>]", g.CommandUsage("give"))
+	assert.Equal(t, "", g.CommandUsage("nope"))
+}
+
+func TestGraphUsageAlternatives(t *testing.T) {
+	g := &commands.Graph{
+		Root: 0,
+		Nodes: []commands.Node{
+			{Type: commands.NodeRoot, Children: []int32{1}, Redirect: -1},
+			{Type: commands.NodeLiteral, Name: "time", Children: []int32{2, 3}, Redirect: -1},
+			{Type: commands.NodeLiteral, Name: "set", Children: []int32{4}, Redirect: -1},
+			{Type: commands.NodeLiteral, Name: "query", Children: []int32{4}, Redirect: -1},
+			{Type: commands.NodeArgument, Name: "time", Executable: true, Redirect: -1,
+				Parser: &commands.Parser{ID: "minecraft:time", Properties: &commands.TimeProperties{}}},
+		},
+	}
+	assert.Equal(t, []string{"/time (set|query)"}, g.Usage())
+
+	// children of an executable node with the same usage are optional, and so is
+	// their usage, like in Brigadier
+	g = &commands.Graph{
+		Root: 0,
+		Nodes: []commands.Node{
+			{Type: commands.NodeRoot, Children: []int32{1}, Redirect: -1},
+			{Type: commands.NodeLiteral, Name: "ping", Executable: true, Children: []int32{2, 3}, Redirect: -1},
+			{Type: commands.NodeArgument, Name: "target", Executable: true, Redirect: -1,
+				Parser: &commands.Parser{ID: "minecraft:entity", Properties: &commands.EntityProperties{}}},
+			{Type: commands.NodeArgument, Name: "target", Executable: true, Redirect: -1,
+				Parser: &commands.Parser{ID: "brigadier:string", Properties: &commands.StringProperties{}}},
+		},
+	}
+	assert.Equal(t, "/ping [[<target>]]", g.CommandUsage("ping"))
+}
+
+func TestValidate(t *testing.T) {
+	g := testGraph()
+	valid := []string{
+		"/give @a minecraft:diamond",
+		"give Notch diamond_sword{display:{Name:'a b'}} 64",
+		"/execute run give @p stone",
+		"say hello there world",
+	}
+	for _, cmd := range valid {
+		assert.NoError(t, g.Validate(cmd), cmd)
+	}
+
+	tests := []struct {
+		command  string
+		cursor   int
+		node     string
+		expected string
+	}{
+		{"/tp 1 2 3", 1, "", "give"},
+		{"/give @a", 8, "targets", "item"},
+		{"/give @a stone 0", 15, "item", "count"},
+		{"/give @e stone", 6, "give", "targets"},
+		{"/give @a stone abc", 15, "item", "count"},
+		{"/execute run", 12, "run", "give"},
+		{"/give @a stone 1 extra", 17, "count", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.command, func(t *testing.T) {
+			err := g.Validate(tt.command)
+			var perr *commands.ParseError
+			require.ErrorAs(t, err, &perr)
+			assert.Equal(t, tt.cursor, perr.Cursor, perr.Error())
+			assert.Equal(t, tt.node, g.Nodes[perr.Node].Name, perr.Error())
+			if tt.expected == "" {
+				assert.Empty(t, perr.Expected)
+			} else {
+				require.NotEmpty(t, perr.Expected)
+				assert.Equal(t, tt.expected, perr.Expected[0].Name)
+			}
+		})
+	}
+
+	err := g.Validate("/give @a stone 0")
+	assert.EqualError(t, err, "commands: integer must not be less than 1, found 0 at position 15: expected <count> (brigadier:integer)")
+}
+
+func TestVanilla(t *testing.T) {
+	g := commands.Vanilla()
+	assert.Equal(t, "/give <targets> <item> [<count>]", g.CommandUsage("give"))
+	assert.NoError(t, g.Validate("/give @a stone 64"))
+
+	err := g.Validate("/give @a stone 0")
+	var perr *commands.ParseError
+	require.ErrorAs(t, err, &perr)
+	assert.Equal(t, 15, perr.Cursor)
+	assert.Equal(t, "integer must not be less than 1, found 0", perr.Reason)
+	require.Len(t, perr.Expected, 1)
+	assert.Equal(t, "count", perr.Expected[0].Name)
+	assert.Equal(t, "brigadier:integer", perr.Expected[0].Parser)
+}
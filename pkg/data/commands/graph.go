@@ -0,0 +1,318 @@
+package commands
+
+import (
+	"fmt"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+)
+
+// NodeType is the kind of a command node.
+type NodeType uint8
+
+const (
+	NodeRoot NodeType = iota
+	NodeLiteral
+	NodeArgument
+)
+
+// node flags, as encoded on the wire
+const (
+	flagTypeMask    = 0x03
+	flagExecutable  = 0x04
+	flagRedirect    = 0x08
+	flagSuggestions = 0x10
+	flagRestricted  = 0x20
+)
+
+// Node is a single node of a Brigadier command graph.
+// Children and Redirect are indices into the owning Graph's Nodes.
+type Node struct {
+	Type       NodeType
+	Executable bool
+	// Restricted marks nodes that require elevated permissions to use.
+	Restricted bool
+	Children   []int32
+	// Redirect is the index of the redirect target, or -1 if there is none.
+	Redirect int32
+	// Name is the literal text or the argument name (empty for the root).
+	Name   string
+	Parser *Parser
+	// Suggestions is the custom suggestion provider (e.g. "minecraft:ask_server"),
+	// or empty if the argument uses the parser's own suggestions.
+	Suggestions string
+}
+
+// HasRedirect returns true if the node redirects to another node.
+func (n *Node) HasRedirect() bool {
+	return n.Redirect >= 0
+}
+
+// UsageText returns the node as it appears in usage strings:
+// literals verbatim, arguments as <name>.
+func (n *Node) UsageText() string {
+	switch n.Type {
+	case NodeLiteral:
+		return n.Name
+	case NodeArgument:
+		return "<" + n.Name + ">"
+	}
+	return ""
+}
+
+// Graph is a Brigadier command graph, as sent in S2CCommands.
+type Graph struct {
+	Nodes []Node
+	Root  int32
+}
+
+// RootNode returns the root node, or nil if the root index is out of range.
+func (g *Graph) RootNode() *Node {
+	return g.node(g.Root)
+}
+
+// Child returns the direct child of the node at index with the given name.
+// Returns -1 if there is no such child.
+func (g *Graph) Child(index int32, name string) int32 {
+	n := g.node(index)
+	if n == nil {
+		return -1
+	}
+	for _, c := range n.Children {
+		if child := g.node(c); child != nil && child.Name == name {
+			return c
+		}
+	}
+	return -1
+}
+
+func (g *Graph) node(index int32) *Node {
+	if index < 0 || int(index) >= len(g.Nodes) {
+		return nil
+	}
+	return &g.Nodes[index]
+}
+
+// Read decodes the graph from the wire format (node array followed by the root index).
+func (g *Graph) Read(buf *ns.PacketBuffer) error {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return fmt.Errorf("commands: invalid node count %d", count)
+	}
+	g.Nodes = make([]Node, count)
+	for i := range g.Nodes {
+		if err := g.Nodes[i].Read(buf); err != nil {
+			return fmt.Errorf("commands: node %d: %w", i, err)
+		}
+	}
+	root, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	g.Root = int32(root)
+	return g.validate()
+}
+
+// Write encodes the graph to the wire format.
+func (g *Graph) Write(buf *ns.PacketBuffer) error {
+	if err := g.validate(); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(len(g.Nodes))); err != nil {
+		return err
+	}
+	for i := range g.Nodes {
+		if err := g.Nodes[i].Write(buf); err != nil {
+			return fmt.Errorf("commands: node %d: %w", i, err)
+		}
+	}
+	return buf.WriteVarInt(ns.VarInt(g.Root))
+}
+
+// validate checks that all node references point inside the graph.
+func (g *Graph) validate() error {
+	if g.node(g.Root) == nil {
+		return fmt.Errorf("commands: root index %d out of range", g.Root)
+	}
+	for i, n := range g.Nodes {
+		for _, c := range n.Children {
+			if g.node(c) == nil {
+				return fmt.Errorf("commands: node %d: child index %d out of range", i, c)
+			}
+		}
+		if n.HasRedirect() && g.node(n.Redirect) == nil {
+			return fmt.Errorf("commands: node %d: redirect index %d out of range", i, n.Redirect)
+		}
+	}
+	return nil
+}
+
+// Read decodes a single node.
+func (n *Node) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	n.Type = NodeType(flags & flagTypeMask)
+	n.Executable = flags&flagExecutable != 0
+	n.Restricted = flags&flagRestricted != 0
+	if n.Type > NodeArgument {
+		return fmt.Errorf("invalid node type %d", n.Type)
+	}
+
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return fmt.Errorf("invalid child count %d", count)
+	}
+	n.Children = nil
+	if count > 0 {
+		n.Children = make([]int32, count)
+	}
+	for i := range n.Children {
+		c, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		n.Children[i] = int32(c)
+	}
+
+	n.Redirect = -1
+	if flags&flagRedirect != 0 {
+		r, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		n.Redirect = int32(r)
+	}
+
+	if n.Type == NodeLiteral || n.Type == NodeArgument {
+		name, err := buf.ReadString(32767)
+		if err != nil {
+			return err
+		}
+		n.Name = string(name)
+	}
+
+	if n.Type == NodeArgument {
+		n.Parser = &Parser{}
+		if err := n.Parser.Read(buf); err != nil {
+			return err
+		}
+	}
+
+	if flags&flagSuggestions != 0 {
+		s, err := buf.ReadIdentifier()
+		if err != nil {
+			return err
+		}
+		n.Suggestions = string(s)
+	}
+	return nil
+}
+
+// Write encodes a single node.
+func (n *Node) Write(buf *ns.PacketBuffer) error {
+	flags := int8(n.Type) & flagTypeMask
+	if n.Executable {
+		flags |= flagExecutable
+	}
+	if n.HasRedirect() {
+		flags |= flagRedirect
+	}
+	if n.Suggestions != "" {
+		flags |= flagSuggestions
+	}
+	if n.Restricted {
+		flags |= flagRestricted
+	}
+	if err := buf.WriteInt8(ns.Int8(flags)); err != nil {
+		return err
+	}
+
+	if err := buf.WriteVarInt(ns.VarInt(len(n.Children))); err != nil {
+		return err
+	}
+	for _, c := range n.Children {
+		if err := buf.WriteVarInt(ns.VarInt(c)); err != nil {
+			return err
+		}
+	}
+
+	if n.HasRedirect() {
+		if err := buf.WriteVarInt(ns.VarInt(n.Redirect)); err != nil {
+			return err
+		}
+	}
+
+	if n.Type == NodeLiteral || n.Type == NodeArgument {
+		if err := buf.WriteString(ns.String(n.Name)); err != nil {
+			return err
+		}
+	}
+
+	if n.Type == NodeArgument {
+		if n.Parser == nil {
+			return fmt.Errorf("argument node %q has no parser", n.Name)
+		}
+		if err := n.Parser.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	if n.Suggestions != "" {
+		return buf.WriteIdentifier(ns.Identifier(n.Suggestions))
+	}
+	return nil
+}
+
+// Parser is the argument parser of an argument node.
+type Parser struct {
+	// ID is the parser identifier from the minecraft:command_argument_type registry.
+	ID string
+	// Properties holds the parser-specific properties, or nil if the parser has none.
+	Properties Properties
+}
+
+// Read decodes the parser ID and its properties.
+func (p *Parser) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	p.ID = registries.CommandArgumentType.ByID(int32(id))
+	if p.ID == "" {
+		return fmt.Errorf("unknown argument parser %d", id)
+	}
+	p.Properties = newProperties(p.ID)
+	if p.Properties == nil {
+		return nil
+	}
+	return p.Properties.Read(buf)
+}
+
+// Write encodes the parser ID and its properties.
+func (p *Parser) Write(buf *ns.PacketBuffer) error {
+	id := registries.CommandArgumentType.Get(p.ID)
+	if id < 0 {
+		return fmt.Errorf("unknown argument parser %q", p.ID)
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	props := p.Properties
+	if props == nil {
+		// parsers with properties always write them, fall back to defaults
+		props = newProperties(p.ID)
+	}
+	if props == nil {
+		return nil
+	}
+	return props.Write(buf)
+}
@@ -0,0 +1,358 @@
+package commands
+
+import (
+	"fmt"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// Properties are the parser-specific properties of an argument node.
+type Properties interface {
+	Read(buf *ns.PacketBuffer) error
+	Write(buf *ns.PacketBuffer) error
+}
+
+// min/max flags of numeric parsers
+const (
+	numberHasMin = 0x01
+	numberHasMax = 0x02
+)
+
+// newProperties returns zero-valued properties for the given parser,
+// or nil if the parser has no properties on the wire.
+func newProperties(parserID string) Properties {
+	switch parserID {
+	case "brigadier:float":
+		return &FloatProperties{}
+	case "brigadier:double":
+		return &DoubleProperties{}
+	case "brigadier:integer":
+		return &IntegerProperties{}
+	case "brigadier:long":
+		return &LongProperties{}
+	case "brigadier:string":
+		return &StringProperties{}
+	case "minecraft:entity":
+		return &EntityProperties{}
+	case "minecraft:score_holder":
+		return &ScoreHolderProperties{}
+	case "minecraft:time":
+		return &TimeProperties{}
+	case "minecraft:resource_or_tag", "minecraft:resource_or_tag_key",
+		"minecraft:resource", "minecraft:resource_key", "minecraft:resource_selector":
+		return &RegistryProperties{}
+	}
+	return nil
+}
+
+func numberFlags(hasMin, hasMax bool) ns.Int8 {
+	var flags ns.Int8
+	if hasMin {
+		flags |= numberHasMin
+	}
+	if hasMax {
+		flags |= numberHasMax
+	}
+	return flags
+}
+
+// ptr returns a pointer to v, for the numeric bounds of the generated vanilla graph.
+func ptr[T any](v T) *T { return &v }
+
+// FloatProperties are the properties of brigadier:float.
+// Nil bounds are unbounded.
+type FloatProperties struct {
+	Min, Max *float32
+}
+
+func (p *FloatProperties) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	if flags&numberHasMin != 0 {
+		v, err := buf.ReadFloat32()
+		if err != nil {
+			return err
+		}
+		lo := float32(v)
+		p.Min = &lo
+	}
+	if flags&numberHasMax != 0 {
+		v, err := buf.ReadFloat32()
+		if err != nil {
+			return err
+		}
+		hi := float32(v)
+		p.Max = &hi
+	}
+	return nil
+}
+
+func (p *FloatProperties) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt8(numberFlags(p.Min != nil, p.Max != nil)); err != nil {
+		return err
+	}
+	if p.Min != nil {
+		if err := buf.WriteFloat32(ns.Float32(*p.Min)); err != nil {
+			return err
+		}
+	}
+	if p.Max != nil {
+		return buf.WriteFloat32(ns.Float32(*p.Max))
+	}
+	return nil
+}
+
+// DoubleProperties are the properties of brigadier:double.
+// Nil bounds are unbounded.
+type DoubleProperties struct {
+	Min, Max *float64
+}
+
+func (p *DoubleProperties) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	if flags&numberHasMin != 0 {
+		v, err := buf.ReadFloat64()
+		if err != nil {
+			return err
+		}
+		lo := float64(v)
+		p.Min = &lo
+	}
+	if flags&numberHasMax != 0 {
+		v, err := buf.ReadFloat64()
+		if err != nil {
+			return err
+		}
+		hi := float64(v)
+		p.Max = &hi
+	}
+	return nil
+}
+
+func (p *DoubleProperties) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt8(numberFlags(p.Min != nil, p.Max != nil)); err != nil {
+		return err
+	}
+	if p.Min != nil {
+		if err := buf.WriteFloat64(ns.Float64(*p.Min)); err != nil {
+			return err
+		}
+	}
+	if p.Max != nil {
+		return buf.WriteFloat64(ns.Float64(*p.Max))
+	}
+	return nil
+}
+
+// IntegerProperties are the properties of brigadier:integer.
+// Nil bounds are unbounded.
+type IntegerProperties struct {
+	Min, Max *int32
+}
+
+func (p *IntegerProperties) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	if flags&numberHasMin != 0 {
+		v, err := buf.ReadInt32()
+		if err != nil {
+			return err
+		}
+		lo := int32(v)
+		p.Min = &lo
+	}
+	if flags&numberHasMax != 0 {
+		v, err := buf.ReadInt32()
+		if err != nil {
+			return err
+		}
+		hi := int32(v)
+		p.Max = &hi
+	}
+	return nil
+}
+
+func (p *IntegerProperties) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt8(numberFlags(p.Min != nil, p.Max != nil)); err != nil {
+		return err
+	}
+	if p.Min != nil {
+		if err := buf.WriteInt32(ns.Int32(*p.Min)); err != nil {
+			return err
+		}
+	}
+	if p.Max != nil {
+		return buf.WriteInt32(ns.Int32(*p.Max))
+	}
+	return nil
+}
+
+// LongProperties are the properties of brigadier:long.
+// Nil bounds are unbounded.
+type LongProperties struct {
+	Min, Max *int64
+}
+
+func (p *LongProperties) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	if flags&numberHasMin != 0 {
+		v, err := buf.ReadInt64()
+		if err != nil {
+			return err
+		}
+		lo := int64(v)
+		p.Min = &lo
+	}
+	if flags&numberHasMax != 0 {
+		v, err := buf.ReadInt64()
+		if err != nil {
+			return err
+		}
+		hi := int64(v)
+		p.Max = &hi
+	}
+	return nil
+}
+
+func (p *LongProperties) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt8(numberFlags(p.Min != nil, p.Max != nil)); err != nil {
+		return err
+	}
+	if p.Min != nil {
+		if err := buf.WriteInt64(ns.Int64(*p.Min)); err != nil {
+			return err
+		}
+	}
+	if p.Max != nil {
+		return buf.WriteInt64(ns.Int64(*p.Max))
+	}
+	return nil
+}
+
+// StringBehavior controls how much input a brigadier:string argument consumes.
+type StringBehavior int32
+
+const (
+	// StringSingleWord reads a single unquoted word.
+	StringSingleWord StringBehavior = iota
+	// StringQuotablePhrase reads a word or a quoted phrase.
+	StringQuotablePhrase
+	// StringGreedyPhrase reads the rest of the input.
+	StringGreedyPhrase
+)
+
+// StringProperties are the properties of brigadier:string.
+type StringProperties struct {
+	Behavior StringBehavior
+}
+
+func (p *StringProperties) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if v < 0 || v > ns.VarInt(StringGreedyPhrase) {
+		return fmt.Errorf("invalid string behavior %d", v)
+	}
+	p.Behavior = StringBehavior(v)
+	return nil
+}
+
+func (p *StringProperties) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(ns.VarInt(p.Behavior))
+}
+
+// EntityProperties are the properties of minecraft:entity.
+type EntityProperties struct {
+	// Single restricts the selector to a single entity.
+	Single bool
+	// PlayersOnly restricts the selector to players.
+	PlayersOnly bool
+}
+
+func (p *EntityProperties) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	p.Single = flags&0x01 != 0
+	p.PlayersOnly = flags&0x02 != 0
+	return nil
+}
+
+func (p *EntityProperties) Write(buf *ns.PacketBuffer) error {
+	var flags ns.Int8
+	if p.Single {
+		flags |= 0x01
+	}
+	if p.PlayersOnly {
+		flags |= 0x02
+	}
+	return buf.WriteInt8(flags)
+}
+
+// ScoreHolderProperties are the properties of minecraft:score_holder.
+type ScoreHolderProperties struct {
+	AllowMultiple bool
+}
+
+func (p *ScoreHolderProperties) Read(buf *ns.PacketBuffer) error {
+	flags, err := buf.ReadInt8()
+	if err != nil {
+		return err
+	}
+	p.AllowMultiple = flags&0x01 != 0
+	return nil
+}
+
+func (p *ScoreHolderProperties) Write(buf *ns.PacketBuffer) error {
+	var flags ns.Int8
+	if p.AllowMultiple {
+		flags |= 0x01
+	}
+	return buf.WriteInt8(flags)
+}
+
+// TimeProperties are the properties of minecraft:time.
+type TimeProperties struct {
+	// Min is the minimum duration in ticks.
+	Min int32
+}
+
+func (p *TimeProperties) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadInt32()
+	p.Min = int32(v)
+	return err
+}
+
+func (p *TimeProperties) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteInt32(ns.Int32(p.Min))
+}
+
+// RegistryProperties are the properties of the registry-backed parsers
+// (minecraft:resource, minecraft:resource_key, minecraft:resource_or_tag,
+// minecraft:resource_or_tag_key and minecraft:resource_selector).
+type RegistryProperties struct {
+	// Registry is the registry the argument refers to, e.g. "minecraft:worldgen/biome".
+	Registry string
+}
+
+func (p *RegistryProperties) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadIdentifier()
+	p.Registry = string(v)
+	return err
+}
+
+func (p *RegistryProperties) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteIdentifier(ns.Identifier(p.Registry))
+}
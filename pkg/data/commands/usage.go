@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"slices"
+	"strings"
+)
+
+// Usage returns the usage string of every top-level command, in graph order,
+// formatted like Brigadier's smart usage (e.g. "/give <targets> <item> [<count>]").
+func (g *Graph) Usage() []string {
+	root := g.RootNode()
+	if root == nil {
+		return nil
+	}
+	usages := make([]string, 0, len(root.Children))
+	for _, c := range root.Children {
+		if u := g.smartUsage(c, false, false, nil); u != "" {
+			usages = append(usages, "/"+u)
+		}
+	}
+	return usages
+}
+
+// CommandUsage returns the usage string of a single top-level command,
+// or an empty string if the command does not exist.
+func (g *Graph) CommandUsage(name string) string {
+	c := g.Child(g.Root, name)
+	if c < 0 {
+		return ""
+	}
+	return "/" + g.smartUsage(c, false, false, nil)
+}
+
+// smartUsage mirrors CommandDispatcher.getSmartUsage: children of executable
+// nodes are optional ([...]), alternatives of non-executable nodes are required ((...)).
+// visiting guards against malformed graphs with child cycles.
+func (g *Graph) smartUsage(index int32, optional, deep bool, visiting map[int32]bool) string {
+	n := g.node(index)
+	if n == nil || visiting[index] {
+		return ""
+	}
+	if visiting == nil {
+		visiting = make(map[int32]bool)
+	}
+	visiting[index] = true
+	defer delete(visiting, index)
+
+	self := n.UsageText()
+	if optional {
+		self = "[" + self + "]"
+	}
+	if deep {
+		return self
+	}
+
+	if n.HasRedirect() {
+		if n.Redirect == g.Root {
+			return self + " ..."
+		}
+		return self + " -> " + g.Nodes[n.Redirect].UsageText()
+	}
+
+	childOptional := n.Executable
+	lb, rb := "(", ")"
+	if childOptional {
+		lb, rb = "[", "]"
+	}
+
+	switch len(n.Children) {
+	case 0:
+		return self
+	case 1:
+		if u := g.smartUsage(n.Children[0], childOptional, childOptional, visiting); u != "" {
+			return self + " " + u
+		}
+		return self
+	}
+
+	var childUsages []string
+	for _, c := range n.Children {
+		u := g.smartUsage(c, childOptional, true, visiting)
+		if u != "" && !slices.Contains(childUsages, u) {
+			childUsages = append(childUsages, u)
+		}
+	}
+	switch len(childUsages) {
+	case 0:
+		return self
+	case 1:
+		if childOptional {
+			return self + " [" + childUsages[0] + "]"
+		}
+		return self + " " + childUsages[0]
+	}
+
+	alternatives := make([]string, 0, len(n.Children))
+	for _, c := range n.Children {
+		alternatives = append(alternatives, g.Nodes[c].UsageText())
+	}
+	return self + " " + lb + strings.Join(alternatives, "|") + rb
+}
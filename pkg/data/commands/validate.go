@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Vanilla returns the vanilla command graph generated from the server reports.
+// The returned graph is shared and must not be modified.
+func Vanilla() *Graph {
+	return &vanillaGraph
+}
+
+// Expected describes a node that could have been parsed at the failure position.
+type Expected struct {
+	Node int32
+	// Name is the literal text or the argument name.
+	Name string
+	// Parser is the argument parser ID (e.g. "brigadier:integer"), or empty for literals.
+	Parser string
+}
+
+func (e Expected) String() string {
+	if e.Parser == "" {
+		return e.Name
+	}
+	return "<" + e.Name + "> (" + e.Parser + ")"
+}
+
+// ParseError reports where a command failed to parse against a graph.
+type ParseError struct {
+	Command string
+	// Cursor is the byte offset in Command where parsing failed.
+	Cursor int
+	// Node is the index of the last node that parsed successfully
+	// (the root if the command name itself is unknown).
+	Node int32
+	// Expected lists the nodes that could follow Node.
+	Expected []Expected
+	// Reason describes the failure, e.g. "unknown command" or "integer must not be less than 1".
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "commands: %s at position %d", e.Reason, e.Cursor)
+	if len(e.Expected) > 0 {
+		expected := make([]string, len(e.Expected))
+		for i, x := range e.Expected {
+			expected[i] = x.String()
+		}
+		sb.WriteString(": expected ")
+		sb.WriteString(strings.Join(expected, ", "))
+	}
+	return sb.String()
+}
+
+// Validate parses a command (with or without the leading slash) against the graph.
+// It returns nil if the command is complete and executable, or a *ParseError
+// describing the deepest point that could be reached.
+func (g *Graph) Validate(command string) error {
+	if g.RootNode() == nil {
+		return fmt.Errorf("commands: root index %d out of range", g.Root)
+	}
+	input := strings.TrimPrefix(command, "/")
+	v := &validator{graph: g, input: input, offset: len(command) - len(input)}
+	if v.parse(g.Root, 0, 0) {
+		return nil
+	}
+	v.best.Command = command
+	return v.best
+}
+
+type validator struct {
+	graph  *Graph
+	input  string
+	offset int // length of the stripped slash prefix
+	best   *ParseError
+}
+
+// fail records a failure if it got further than the previous best.
+func (v *validator) fail(cursor int, node int32, reason string, expected []Expected) {
+	if v.best != nil && v.best.Cursor > cursor+v.offset {
+		return
+	}
+	v.best = &ParseError{Cursor: cursor + v.offset, Node: node, Reason: reason, Expected: expected}
+}
+
+// parse tries to parse the remaining input starting after node at cursor.
+// depth bounds redirect loops.
+func (v *validator) parse(index int32, cursor, depth int) bool {
+	if depth > 256 {
+		v.fail(cursor, index, "command too deep", nil)
+		return false
+	}
+	n := &v.graph.Nodes[index]
+	source := index
+	if n.HasRedirect() {
+		source = n.Redirect
+	}
+	children := v.graph.Nodes[source].Children
+	if len(children) == 0 {
+		v.fail(cursor, index, "incorrect argument", nil)
+		return false
+	}
+	expected := v.expected(children)
+	if cursor >= len(v.input) {
+		reason := "incomplete command"
+		if index == v.graph.Root {
+			reason = "empty command"
+		}
+		v.fail(cursor, index, reason, expected)
+		return false
+	}
+
+	// like Brigadier, a matching literal takes precedence over all arguments
+	word := readWord(v.input[cursor:])
+	for _, c := range children {
+		child := &v.graph.Nodes[c]
+		if child.Type == NodeLiteral && child.Name == word {
+			return v.parseChild(c, cursor+len(word), depth)
+		}
+	}
+
+	matched := false
+	for _, c := range children {
+		child := &v.graph.Nodes[c]
+		if child.Type != NodeArgument {
+			continue
+		}
+		matched = true
+		end, err := parseArgument(child.Parser, v.input, cursor)
+		if err != nil {
+			v.fail(cursor, index, err.Error(), []Expected{v.expectedNode(c)})
+			continue
+		}
+		if v.parseChild(c, end, depth) {
+			return true
+		}
+	}
+	if !matched {
+		reason := "incorrect argument"
+		if index == v.graph.Root {
+			reason = "unknown command"
+		}
+		v.fail(cursor, index, reason, expected)
+	}
+	return false
+}
+
+// parseChild continues after the child node consumed the input up to end.
+func (v *validator) parseChild(index int32, end, depth int) bool {
+	n := &v.graph.Nodes[index]
+	if end == len(v.input) {
+		if n.Executable {
+			return true
+		}
+		return v.parse(index, end, depth+1)
+	}
+	if v.input[end] != ' ' {
+		v.fail(end, index, "expected whitespace to end one argument", nil)
+		return false
+	}
+	return v.parse(index, end+1, depth+1)
+}
+
+func (v *validator) expected(children []int32) []Expected {
+	expected := make([]Expected, len(children))
+	for i, c := range children {
+		expected[i] = v.expectedNode(c)
+	}
+	return expected
+}
+
+func (v *validator) expectedNode(index int32) Expected {
+	n := &v.graph.Nodes[index]
+	e := Expected{Node: index, Name: n.Name}
+	if n.Parser != nil {
+		e.Parser = n.Parser.ID
+	}
+	return e
+}
@@ -0,0 +1,940 @@
+// Package debug decodes the values of debug subscriptions, which a server sends
+// to clients that requested them with C2SDebugSubscriptionRequest, and the
+// remote debug samples of S2CDebugSample.
+package debug
+
+import (
+	"fmt"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// Subscriptions of the minecraft:debug_subscription registry.
+const (
+	DedicatedServerTickTime  = "minecraft:dedicated_server_tick_time"
+	Bees                     = "minecraft:bees"
+	Brains                   = "minecraft:brains"
+	Breezes                  = "minecraft:breezes"
+	GoalSelectors            = "minecraft:goal_selectors"
+	EntityPaths              = "minecraft:entity_paths"
+	EntityBlockIntersections = "minecraft:entity_block_intersections"
+	BeeHives                 = "minecraft:bee_hives"
+	Pois                     = "minecraft:pois"
+	RedstoneWireOrientations = "minecraft:redstone_wire_orientations"
+	VillageSections          = "minecraft:village_sections"
+	Raids                    = "minecraft:raids"
+	Structures               = "minecraft:structures"
+	GameEventListeners       = "minecraft:game_event_listeners"
+	NeighborUpdates          = "minecraft:neighbor_updates"
+	GameEvents               = "minecraft:game_events"
+)
+
+// Value is the value of a debug subscription, e.g. *BeeInfo.
+type Value interface {
+	Read(buf *ns.PacketBuffer) error
+	Write(buf *ns.PacketBuffer) error
+}
+
+// newValue returns a zero value for a subscription, or nil if the subscription
+// is unknown.
+func newValue(subscription string) Value {
+	switch subscription {
+	case DedicatedServerTickTime, VillageSections:
+		return &Unit{}
+	case Bees:
+		return &BeeInfo{}
+	case Brains:
+		return &BrainDump{}
+	case Breezes:
+		return &BreezeInfo{}
+	case GoalSelectors:
+		return &GoalInfo{}
+	case EntityPaths:
+		return &PathInfo{}
+	case EntityBlockIntersections:
+		return new(EntityBlockIntersection)
+	case BeeHives:
+		return &HiveInfo{}
+	case Pois:
+		return &PoiInfo{}
+	case RedstoneWireOrientations:
+		return new(Orientation)
+	case Raids:
+		return &RaidCenters{}
+	case Structures:
+		return &StructureList{}
+	case GameEventListeners:
+		return &GameEventListenerInfo{}
+	case NeighborUpdates:
+		return &BlockPos{}
+	case GameEvents:
+		return &GameEventInfo{}
+	}
+	return nil
+}
+
+// SubscriptionIDs returns the registry IDs of the given subscriptions, for
+// C2SDebugSubscriptionRequest.
+func SubscriptionIDs(subscriptions ...string) ([]ns.VarInt, error) {
+	ids := make([]ns.VarInt, len(subscriptions))
+	for i, s := range subscriptions {
+		id := registries.DebugSubscription.Get(s)
+		if id < 0 {
+			return nil, fmt.Errorf("unknown debug subscription %q", s)
+		}
+		ids[i] = ns.VarInt(id)
+	}
+	return ids, nil
+}
+
+func readSubscription(buf *ns.PacketBuffer) (string, Value, error) {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return "", nil, err
+	}
+	subscription := registries.DebugSubscription.ByID(int32(id))
+	value := newValue(subscription)
+	if value == nil {
+		return "", nil, fmt.Errorf("unknown debug subscription %d", id)
+	}
+	return subscription, value, nil
+}
+
+func writeSubscription(buf *ns.PacketBuffer, subscription string) error {
+	id := registries.DebugSubscription.Get(subscription)
+	if id < 0 {
+		return fmt.Errorf("unknown debug subscription %q", subscription)
+	}
+	return buf.WriteVarInt(ns.VarInt(id))
+}
+
+// Update is the new value of a subscription for a block, chunk or entity, as sent
+// in S2CDebugBlockValue, S2CDebugChunkValue and S2CDebugEntityValue.
+type Update struct {
+	// Subscription is the registry entry, e.g. "minecraft:bees".
+	Subscription string
+	// Value is the new value, or nil if the value was removed.
+	Value Value
+}
+
+func (u *Update) Read(buf *ns.PacketBuffer) error {
+	subscription, value, err := readSubscription(buf)
+	if err != nil {
+		return err
+	}
+	u.Subscription, u.Value = subscription, nil
+	present, err := buf.ReadBool()
+	if err != nil || !present {
+		return err
+	}
+	u.Value = value
+	return value.Read(buf)
+}
+
+func (u *Update) Write(buf *ns.PacketBuffer) error {
+	if err := writeSubscription(buf, u.Subscription); err != nil {
+		return err
+	}
+	if err := buf.WriteBool(u.Value != nil); err != nil {
+		return err
+	}
+	if u.Value == nil {
+		return nil
+	}
+	return u.Value.Write(buf)
+}
+
+// Event is a one-off subscription value, as sent in S2CDebugEvent.
+type Event struct {
+	// Subscription is the registry entry, e.g. "minecraft:game_events".
+	Subscription string
+	Value        Value
+}
+
+func (e *Event) Read(buf *ns.PacketBuffer) error {
+	subscription, value, err := readSubscription(buf)
+	if err != nil {
+		return err
+	}
+	e.Subscription, e.Value = subscription, value
+	return value.Read(buf)
+}
+
+func (e *Event) Write(buf *ns.PacketBuffer) error {
+	if e.Value == nil {
+		return fmt.Errorf("debug event %s has no value", e.Subscription)
+	}
+	if err := writeSubscription(buf, e.Subscription); err != nil {
+		return err
+	}
+	return e.Value.Write(buf)
+}
+
+// Unit is the value of subscriptions that only mark their target, like
+// dedicated_server_tick_time and village_sections.
+type Unit struct{}
+
+func (*Unit) Read(*ns.PacketBuffer) error  { return nil }
+func (*Unit) Write(*ns.PacketBuffer) error { return nil }
+
+// BlockPos is a block position, the value of neighbor_updates events.
+type BlockPos ns.Position
+
+func (p *BlockPos) Read(buf *ns.PacketBuffer) error {
+	pos, err := buf.ReadPosition()
+	*p = BlockPos(pos)
+	return err
+}
+
+func (p *BlockPos) Write(buf *ns.PacketBuffer) error {
+	return buf.WritePosition(ns.Position(*p))
+}
+
+// BeeInfo is the value of bees subscriptions.
+type BeeInfo struct {
+	Hive        *ns.Position
+	Flower      *ns.Position
+	TravelTicks int32
+	// BlacklistedHives are the hives the bee won't enter.
+	BlacklistedHives []ns.Position
+}
+
+func (v *BeeInfo) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if v.Hive, err = readOptionalPosition(buf); err != nil {
+		return err
+	}
+	if v.Flower, err = readOptionalPosition(buf); err != nil {
+		return err
+	}
+	ticks, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	v.TravelTicks = int32(ticks)
+	v.BlacklistedHives, err = readPositions(buf)
+	return err
+}
+
+func (v *BeeInfo) Write(buf *ns.PacketBuffer) error {
+	if err := writeOptionalPosition(buf, v.Hive); err != nil {
+		return err
+	}
+	if err := writeOptionalPosition(buf, v.Flower); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(v.TravelTicks)); err != nil {
+		return err
+	}
+	return writePositions(buf, v.BlacklistedHives)
+}
+
+// BrainDump is the value of brains subscriptions: the AI state of a mob.
+type BrainDump struct {
+	Name       string
+	Profession string
+	XP         int32
+	Health     float32
+	MaxHealth  float32
+	Inventory  string
+	WantsGolem bool
+	AngerLevel int32
+	Activities []string
+	Behaviors  []string
+	Memories   []string
+	Gossips    []string
+	// Pois and PotentialPois are the points of interest the mob claimed or may claim.
+	Pois          []ns.Position
+	PotentialPois []ns.Position
+}
+
+func (v *BrainDump) Read(buf *ns.PacketBuffer) error {
+	var err error
+	for _, s := range []*string{&v.Name, &v.Profession} {
+		if *s, err = readString(buf); err != nil {
+			return err
+		}
+	}
+	xp, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	health, err := buf.ReadFloat32()
+	if err != nil {
+		return err
+	}
+	maxHealth, err := buf.ReadFloat32()
+	if err != nil {
+		return err
+	}
+	v.XP, v.Health, v.MaxHealth = int32(xp), float32(health), float32(maxHealth)
+	if v.Inventory, err = readString(buf); err != nil {
+		return err
+	}
+	wantsGolem, err := buf.ReadBool()
+	if err != nil {
+		return err
+	}
+	anger, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	v.WantsGolem, v.AngerLevel = bool(wantsGolem), int32(anger)
+	for _, list := range []*[]string{&v.Activities, &v.Behaviors, &v.Memories, &v.Gossips} {
+		if *list, err = readStrings(buf); err != nil {
+			return err
+		}
+	}
+	if v.Pois, err = readPositions(buf); err != nil {
+		return err
+	}
+	v.PotentialPois, err = readPositions(buf)
+	return err
+}
+
+func (v *BrainDump) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteString(ns.String(v.Name)); err != nil {
+		return err
+	}
+	if err := buf.WriteString(ns.String(v.Profession)); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(ns.Int32(v.XP)); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(ns.Float32(v.Health)); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(ns.Float32(v.MaxHealth)); err != nil {
+		return err
+	}
+	if err := buf.WriteString(ns.String(v.Inventory)); err != nil {
+		return err
+	}
+	if err := buf.WriteBool(ns.Boolean(v.WantsGolem)); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(ns.Int32(v.AngerLevel)); err != nil {
+		return err
+	}
+	for _, list := range [][]string{v.Activities, v.Behaviors, v.Memories, v.Gossips} {
+		if err := writeStrings(buf, list); err != nil {
+			return err
+		}
+	}
+	if err := writePositions(buf, v.Pois); err != nil {
+		return err
+	}
+	return writePositions(buf, v.PotentialPois)
+}
+
+// BreezeInfo is the value of breezes subscriptions.
+type BreezeInfo struct {
+	// AttackTarget is the entity ID of the attack target, if any.
+	AttackTarget *int32
+	JumpTarget   *ns.Position
+}
+
+func (v *BreezeInfo) Read(buf *ns.PacketBuffer) error {
+	v.AttackTarget = nil
+	present, err := buf.ReadBool()
+	if err != nil {
+		return err
+	}
+	if present {
+		id, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		target := int32(id)
+		v.AttackTarget = &target
+	}
+	v.JumpTarget, err = readOptionalPosition(buf)
+	return err
+}
+
+func (v *BreezeInfo) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteBool(v.AttackTarget != nil); err != nil {
+		return err
+	}
+	if v.AttackTarget != nil {
+		if err := buf.WriteVarInt(ns.VarInt(*v.AttackTarget)); err != nil {
+			return err
+		}
+	}
+	return writeOptionalPosition(buf, v.JumpTarget)
+}
+
+// Goal is a goal of a mob's goal selector.
+type Goal struct {
+	Priority  int32
+	IsRunning bool
+	Name      string
+}
+
+// GoalInfo is the value of goal_selectors subscriptions.
+type GoalInfo struct {
+	Goals []Goal
+}
+
+func (v *GoalInfo) Read(buf *ns.PacketBuffer) error {
+	count, err := readCount(buf)
+	if err != nil {
+		return err
+	}
+	v.Goals = make([]Goal, count)
+	for i := range v.Goals {
+		priority, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		running, err := buf.ReadBool()
+		if err != nil {
+			return err
+		}
+		v.Goals[i] = Goal{Priority: int32(priority), IsRunning: bool(running)}
+		if v.Goals[i].Name, err = readString(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *GoalInfo) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(v.Goals))); err != nil {
+		return err
+	}
+	for _, g := range v.Goals {
+		if err := buf.WriteVarInt(ns.VarInt(g.Priority)); err != nil {
+			return err
+		}
+		if err := buf.WriteBool(ns.Boolean(g.IsRunning)); err != nil {
+			return err
+		}
+		if err := buf.WriteString(ns.String(g.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PathNode is a node of a pathfinding path.
+type PathNode struct {
+	X, Y, Z        int32
+	WalkedDistance float32
+	CostMalus      float32
+	Closed         bool
+	// Type is the ordinal of the node's path type, e.g. 1 for OPEN.
+	Type int32
+	// F is the node's estimated total cost.
+	F float32
+}
+
+func (n *PathNode) read(buf *ns.PacketBuffer) error {
+	for _, c := range []*int32{&n.X, &n.Y, &n.Z} {
+		v, err := buf.ReadInt32()
+		if err != nil {
+			return err
+		}
+		*c = int32(v)
+	}
+	walked, err := buf.ReadFloat32()
+	if err != nil {
+		return err
+	}
+	malus, err := buf.ReadFloat32()
+	if err != nil {
+		return err
+	}
+	closed, err := buf.ReadBool()
+	if err != nil {
+		return err
+	}
+	pathType, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	f, err := buf.ReadFloat32()
+	n.WalkedDistance, n.CostMalus, n.Closed, n.Type, n.F = float32(walked), float32(malus), bool(closed), int32(pathType), float32(f)
+	return err
+}
+
+func (n *PathNode) write(buf *ns.PacketBuffer) error {
+	for _, c := range []int32{n.X, n.Y, n.Z} {
+		if err := buf.WriteInt32(ns.Int32(c)); err != nil {
+			return err
+		}
+	}
+	if err := buf.WriteFloat32(ns.Float32(n.WalkedDistance)); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(ns.Float32(n.CostMalus)); err != nil {
+		return err
+	}
+	if err := buf.WriteBool(ns.Boolean(n.Closed)); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(n.Type)); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(ns.Float32(n.F))
+}
+
+func readNodes(buf *ns.PacketBuffer) ([]PathNode, error) {
+	count, err := readCount(buf)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]PathNode, count)
+	for i := range nodes {
+		if err := nodes[i].read(buf); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func writeNodes(buf *ns.PacketBuffer, nodes []PathNode) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(nodes))); err != nil {
+		return err
+	}
+	for i := range nodes {
+		if err := nodes[i].write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path is a pathfinding path with the pathfinder's debug data.
+type Path struct {
+	Reached bool
+	// NextNodeIndex is the index in Nodes the mob is moving to.
+	NextNodeIndex int32
+	Target        ns.Position
+	Nodes         []PathNode
+	// TargetNodes, OpenSet and ClosedSet are the pathfinder's state when the path
+	// was computed.
+	TargetNodes []PathNode
+	OpenSet     []PathNode
+	ClosedSet   []PathNode
+}
+
+// PathInfo is the value of entity_paths subscriptions.
+type PathInfo struct {
+	Path            Path
+	MaxNodeDistance float32
+}
+
+func (v *PathInfo) Read(buf *ns.PacketBuffer) error {
+	p := &v.Path
+	reached, err := buf.ReadBool()
+	if err != nil {
+		return err
+	}
+	next, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	p.Reached, p.NextNodeIndex = bool(reached), int32(next)
+	if p.Target, err = buf.ReadPosition(); err != nil {
+		return err
+	}
+	for _, nodes := range []*[]PathNode{&p.Nodes, &p.TargetNodes, &p.OpenSet, &p.ClosedSet} {
+		if *nodes, err = readNodes(buf); err != nil {
+			return err
+		}
+	}
+	maxDistance, err := buf.ReadFloat32()
+	v.MaxNodeDistance = float32(maxDistance)
+	return err
+}
+
+func (v *PathInfo) Write(buf *ns.PacketBuffer) error {
+	p := &v.Path
+	if err := buf.WriteBool(ns.Boolean(p.Reached)); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(ns.Int32(p.NextNodeIndex)); err != nil {
+		return err
+	}
+	if err := buf.WritePosition(p.Target); err != nil {
+		return err
+	}
+	for _, nodes := range [][]PathNode{p.Nodes, p.TargetNodes, p.OpenSet, p.ClosedSet} {
+		if err := writeNodes(buf, nodes); err != nil {
+			return err
+		}
+	}
+	return buf.WriteFloat32(ns.Float32(v.MaxNodeDistance))
+}
+
+// EntityBlockIntersection is the value of entity_block_intersections updates.
+type EntityBlockIntersection int32
+
+const (
+	IntersectionInBlock EntityBlockIntersection = iota
+	IntersectionInFluid
+	IntersectionInAir
+)
+
+func (v *EntityBlockIntersection) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	*v = EntityBlockIntersection(id)
+	return err
+}
+
+func (v *EntityBlockIntersection) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(ns.VarInt(*v))
+}
+
+// HiveInfo is the value of bee_hives subscriptions.
+type HiveInfo struct {
+	// Block is the hive block, e.g. "minecraft:beehive".
+	Block         string
+	OccupantCount int32
+	HoneyLevel    int32
+	Sedated       bool
+}
+
+func (v *HiveInfo) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if v.Block = registries.Block.ByID(int32(id)); v.Block == "" {
+		return fmt.Errorf("unknown block %d", id)
+	}
+	occupants, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	honey, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	sedated, err := buf.ReadBool()
+	v.OccupantCount, v.HoneyLevel, v.Sedated = int32(occupants), int32(honey), bool(sedated)
+	return err
+}
+
+func (v *HiveInfo) Write(buf *ns.PacketBuffer) error {
+	id := registries.Block.Get(v.Block)
+	if id < 0 {
+		return fmt.Errorf("unknown block %q", v.Block)
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(v.OccupantCount)); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(v.HoneyLevel)); err != nil {
+		return err
+	}
+	return buf.WriteBool(ns.Boolean(v.Sedated))
+}
+
+// PoiInfo is the value of pois subscriptions.
+type PoiInfo struct {
+	Pos ns.Position
+	// Type is the minecraft:point_of_interest_type entry, e.g. "minecraft:home".
+	Type            string
+	FreeTicketCount int32
+}
+
+func (v *PoiInfo) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if v.Pos, err = buf.ReadPosition(); err != nil {
+		return err
+	}
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if v.Type = registries.PointOfInterestType.ByID(int32(id)); v.Type == "" {
+		return fmt.Errorf("unknown point of interest type %d", id)
+	}
+	tickets, err := buf.ReadVarInt()
+	v.FreeTicketCount = int32(tickets)
+	return err
+}
+
+func (v *PoiInfo) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WritePosition(v.Pos); err != nil {
+		return err
+	}
+	id := registries.PointOfInterestType.Get(v.Type)
+	if id < 0 {
+		return fmt.Errorf("unknown point of interest type %q", v.Type)
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(ns.VarInt(v.FreeTicketCount))
+}
+
+// Orientation is the value of redstone_wire_orientations updates: the direction
+// a redstone update travelled, encoded like the vanilla Orientation index.
+type Orientation int32
+
+func (v *Orientation) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	*v = Orientation(id)
+	return err
+}
+
+func (v *Orientation) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(ns.VarInt(*v))
+}
+
+// RaidCenters is the value of raids subscriptions: the centers of the active raids.
+type RaidCenters struct {
+	Centers []ns.Position
+}
+
+func (v *RaidCenters) Read(buf *ns.PacketBuffer) error {
+	var err error
+	v.Centers, err = readPositions(buf)
+	return err
+}
+
+func (v *RaidCenters) Write(buf *ns.PacketBuffer) error {
+	return writePositions(buf, v.Centers)
+}
+
+// BoundingBox is an inclusive block box.
+type BoundingBox struct {
+	Min, Max ns.Position
+}
+
+func (b *BoundingBox) read(buf *ns.PacketBuffer) error {
+	var err error
+	if b.Min, err = buf.ReadPosition(); err != nil {
+		return err
+	}
+	b.Max, err = buf.ReadPosition()
+	return err
+}
+
+func (b *BoundingBox) write(buf *ns.PacketBuffer) error {
+	if err := buf.WritePosition(b.Min); err != nil {
+		return err
+	}
+	return buf.WritePosition(b.Max)
+}
+
+// StructurePiece is a piece of a structure.
+type StructurePiece struct {
+	BoundingBox BoundingBox
+	IsStart     bool
+}
+
+// StructureInfo is a structure in a chunk.
+type StructureInfo struct {
+	BoundingBox BoundingBox
+	Pieces      []StructurePiece
+}
+
+// StructureList is the value of structures subscriptions.
+type StructureList struct {
+	Structures []StructureInfo
+}
+
+func (v *StructureList) Read(buf *ns.PacketBuffer) error {
+	count, err := readCount(buf)
+	if err != nil {
+		return err
+	}
+	v.Structures = make([]StructureInfo, count)
+	for i := range v.Structures {
+		s := &v.Structures[i]
+		if err := s.BoundingBox.read(buf); err != nil {
+			return err
+		}
+		pieces, err := readCount(buf)
+		if err != nil {
+			return err
+		}
+		s.Pieces = make([]StructurePiece, pieces)
+		for j := range s.Pieces {
+			if err := s.Pieces[j].BoundingBox.read(buf); err != nil {
+				return err
+			}
+			start, err := buf.ReadBool()
+			if err != nil {
+				return err
+			}
+			s.Pieces[j].IsStart = bool(start)
+		}
+	}
+	return nil
+}
+
+func (v *StructureList) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(v.Structures))); err != nil {
+		return err
+	}
+	for i := range v.Structures {
+		s := &v.Structures[i]
+		if err := s.BoundingBox.write(buf); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(ns.VarInt(len(s.Pieces))); err != nil {
+			return err
+		}
+		for j := range s.Pieces {
+			if err := s.Pieces[j].BoundingBox.write(buf); err != nil {
+				return err
+			}
+			if err := buf.WriteBool(ns.Boolean(s.Pieces[j].IsStart)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GameEventListenerInfo is the value of game_event_listeners subscriptions.
+type GameEventListenerInfo struct {
+	ListenerRadius int32
+}
+
+func (v *GameEventListenerInfo) Read(buf *ns.PacketBuffer) error {
+	radius, err := buf.ReadVarInt()
+	v.ListenerRadius = int32(radius)
+	return err
+}
+
+func (v *GameEventListenerInfo) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(ns.VarInt(v.ListenerRadius))
+}
+
+// GameEventInfo is the value of game_events events.
+type GameEventInfo struct {
+	// Event is the minecraft:game_event entry, e.g. "minecraft:step".
+	Event   string
+	X, Y, Z float64
+}
+
+func (v *GameEventInfo) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if v.Event = registries.GameEvent.ByID(int32(id)); v.Event == "" {
+		return fmt.Errorf("unknown game event %d", id)
+	}
+	for _, c := range []*float64{&v.X, &v.Y, &v.Z} {
+		f, err := buf.ReadFloat64()
+		if err != nil {
+			return err
+		}
+		*c = float64(f)
+	}
+	return nil
+}
+
+func (v *GameEventInfo) Write(buf *ns.PacketBuffer) error {
+	id := registries.GameEvent.Get(v.Event)
+	if id < 0 {
+		return fmt.Errorf("unknown game event %q", v.Event)
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	for _, c := range []float64{v.X, v.Y, v.Z} {
+		if err := buf.WriteFloat64(ns.Float64(c)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readCount(buf *ns.PacketBuffer) (int, error) {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("negative list length %d", count)
+	}
+	return int(count), nil
+}
+
+func readString(buf *ns.PacketBuffer) (string, error) {
+	s, err := buf.ReadString(32767)
+	return string(s), err
+}
+
+func readStrings(buf *ns.PacketBuffer) ([]string, error) {
+	count, err := readCount(buf)
+	if err != nil {
+		return nil, err
+	}
+	strs := make([]string, count)
+	for i := range strs {
+		if strs[i], err = readString(buf); err != nil {
+			return nil, err
+		}
+	}
+	return strs, nil
+}
+
+func writeStrings(buf *ns.PacketBuffer, strs []string) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(strs))); err != nil {
+		return err
+	}
+	for _, s := range strs {
+		if err := buf.WriteString(ns.String(s)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readPositions(buf *ns.PacketBuffer) ([]ns.Position, error) {
+	count, err := readCount(buf)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]ns.Position, count)
+	for i := range positions {
+		if positions[i], err = buf.ReadPosition(); err != nil {
+			return nil, err
+		}
+	}
+	return positions, nil
+}
+
+func writePositions(buf *ns.PacketBuffer, positions []ns.Position) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(positions))); err != nil {
+		return err
+	}
+	for _, pos := range positions {
+		if err := buf.WritePosition(pos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readOptionalPosition(buf *ns.PacketBuffer) (*ns.Position, error) {
+	present, err := buf.ReadBool()
+	if err != nil || !present {
+		return nil, err
+	}
+	pos, err := buf.ReadPosition()
+	return &pos, err
+}
+
+func writeOptionalPosition(buf *ns.PacketBuffer, pos *ns.Position) error {
+	if err := buf.WriteBool(pos != nil); err != nil {
+		return err
+	}
+	if pos == nil {
+		return nil
+	}
+	return buf.WritePosition(*pos)
+}
@@ -0,0 +1,46 @@
+package debug_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mclib/data/pkg/data/debug"
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdates(t *testing.T) {
+	// subscription ID, then an optional value
+	buf := ns.NewWriter()
+	require.NoError(t, (&debug.Update{Subscription: debug.GameEventListeners, Value: &debug.GameEventListenerInfo{ListenerRadius: 16}}).Write(buf))
+	assert.Equal(t, []byte{byte(registries.DebugSubscription.Get(debug.GameEventListeners)), 0x01, 0x10}, buf.Bytes())
+
+	assert.Error(t, (&debug.Update{Subscription: "minecraft:unknown"}).Write(ns.NewWriter()))
+	assert.Error(t, (&debug.Update{}).Read(ns.NewReader([]byte{0x7f, 0x00})))
+	// events have no optional value
+	assert.Error(t, (&debug.Event{Subscription: debug.GameEvents}).Write(ns.NewWriter()))
+}
+
+func TestTickTimeSample(t *testing.T) {
+	p := &packets.S2CDebugSample{
+		Sample:     []ns.Int64{50_000_000, 12_000_000, 500_000, 37_500_000},
+		SampleType: ns.VarInt(debug.SampleTickTime),
+	}
+	sample, err := p.TickTimeSample()
+	require.NoError(t, err)
+	assert.Equal(t, 12*time.Millisecond, sample.ServerTick)
+	assert.Equal(t, 12.5, sample.MSPT())
+	assert.Equal(t, []int64{50_000_000, 12_000_000, 500_000, 37_500_000}, sample.Values())
+
+	_, err = debug.ParseTickTimeSample([]int64{1, 2})
+	assert.Error(t, err)
+
+	ids, err := debug.SubscriptionIDs(debug.DedicatedServerTickTime, debug.EntityPaths)
+	require.NoError(t, err)
+	assert.Equal(t, []ns.VarInt{0, 5}, ids)
+	_, err = debug.SubscriptionIDs("minecraft:unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"fmt"
+	"time"
+)
+
+// Remote debug sample types of S2CDebugSample.
+const (
+	SampleTickTime int32 = iota
+)
+
+// TickTimeSample is a tick time sample, sent to clients subscribed to
+// dedicated_server_tick_time once per server tick.
+type TickTimeSample struct {
+	// FullTick is the whole tick duration, including the idle time until the next tick.
+	FullTick time.Duration
+	// ServerTick is the time spent ticking the server (levels, connections, ...).
+	ServerTick time.Duration
+	// ScheduledTasks is the time spent on scheduled tasks between ticks.
+	ScheduledTasks time.Duration
+	// Idle is the time the server waited for the next tick.
+	Idle time.Duration
+}
+
+// ParseTickTimeSample parses the sample of a tick time S2CDebugSample, which
+// holds the nanosecond durations of each tick part.
+func ParseTickTimeSample(sample []int64) (TickTimeSample, error) {
+	if len(sample) != 4 {
+		return TickTimeSample{}, fmt.Errorf("tick time sample has %d values, want 4", len(sample))
+	}
+	return TickTimeSample{
+		FullTick:       time.Duration(sample[0]),
+		ServerTick:     time.Duration(sample[1]),
+		ScheduledTasks: time.Duration(sample[2]),
+		Idle:           time.Duration(sample[3]),
+	}, nil
+}
+
+// Values returns the sample in wire order.
+func (s TickTimeSample) Values() []int64 {
+	return []int64{int64(s.FullTick), int64(s.ServerTick), int64(s.ScheduledTasks), int64(s.Idle)}
+}
+
+// MSPT returns the milliseconds the server was busy during the tick, as shown by
+// the vanilla debug screen.
+func (s TickTimeSample) MSPT() float64 {
+	return float64(s.FullTick-s.Idle) / float64(time.Millisecond)
+}
@@ -0,0 +1,731 @@
+// Package dialogs provides typed dialog definitions, as sent in S2CShowDialog packets
+// and the minecraft:dialog registry, and builds the responses to their actions.
+package dialogs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// dialog types
+const (
+	TypeNotice       = "minecraft:notice"
+	TypeConfirmation = "minecraft:confirmation"
+	TypeMultiAction  = "minecraft:multi_action"
+	TypeServerLinks  = "minecraft:server_links"
+	TypeDialogList   = "minecraft:dialog_list"
+)
+
+// actions taken after a dialog button is clicked (Dialog.AfterAction)
+const (
+	AfterActionClose           = "close"
+	AfterActionNone            = "none"
+	AfterActionWaitForResponse = "wait_for_response"
+)
+
+// Dialog is a dialog definition. The fields after Inputs depend on the dialog type.
+type Dialog struct {
+	Type               string
+	Title              ns.TextComponent
+	ExternalTitle      *ns.TextComponent
+	CanCloseWithEscape bool
+	Pause              bool
+	AfterAction        string
+	Body               []Body
+	Inputs             []Input
+
+	// Action is the button of notice dialogs.
+	Action *ActionButton
+	// Yes and No are the buttons of confirmation dialogs.
+	Yes, No *ActionButton
+	// Actions are the buttons of multi-action dialogs.
+	Actions []ActionButton
+	// ExitAction is the optional exit button of multi-action, server links and dialog list dialogs.
+	ExitAction *ActionButton
+	// Columns and ButtonWidth lay out multi-action, server links and dialog list dialogs.
+	Columns     int32
+	ButtonWidth int32
+	// Dialogs are the entries of dialog list dialogs, unless they are given by DialogTag.
+	Dialogs   []DialogEntry
+	DialogTag string
+}
+
+// DialogEntry is a registered dialog, by name, or an inline dialog of a dialog list.
+type DialogEntry struct {
+	Name   string
+	Inline *Dialog
+}
+
+// Buttons returns the action buttons of the dialog, including the exit button.
+func (d *Dialog) Buttons() []*ActionButton {
+	var buttons []*ActionButton
+	for _, b := range []*ActionButton{d.Action, d.Yes, d.No} {
+		if b != nil {
+			buttons = append(buttons, b)
+		}
+	}
+	for i := range d.Actions {
+		buttons = append(buttons, &d.Actions[i])
+	}
+	if d.ExitAction != nil {
+		buttons = append(buttons, d.ExitAction)
+	}
+	return buttons
+}
+
+// Input returns the input with the given key, or nil.
+func (d *Dialog) Input(key string) Input {
+	for _, in := range d.Inputs {
+		if in.InputKey() == key {
+			return in
+		}
+	}
+	return nil
+}
+
+// ActionButton is a dialog button.
+type ActionButton struct {
+	Label   ns.TextComponent
+	Tooltip *ns.TextComponent
+	Width   int32
+	// Action is run when the button is clicked; nil buttons only close the dialog.
+	Action *Action
+}
+
+// action types (Action.Type)
+const (
+	ActionOpenURL           = "minecraft:open_url"
+	ActionRunCommand        = "minecraft:run_command"
+	ActionSuggestCommand    = "minecraft:suggest_command"
+	ActionChangePage        = "minecraft:change_page"
+	ActionCopyToClipboard   = "minecraft:copy_to_clipboard"
+	ActionShowDialog        = "minecraft:show_dialog"
+	ActionCustom            = "minecraft:custom"
+	ActionDynamicRunCommand = "minecraft:dynamic/run_command"
+	ActionDynamicCustom     = "minecraft:dynamic/custom"
+)
+
+// Action is the click action of a dialog button. The fields used depend on the type.
+type Action struct {
+	Type    string
+	URL     string // open_url
+	Command string // run_command, suggest_command
+	Page    int32  // change_page
+	Value   string // copy_to_clipboard
+	// Dialog is the dialog to show, a registry name or an inline definition (show_dialog).
+	Dialog DialogEntry
+	ID     string // custom, dynamic/custom
+	// Payload is the optional payload of custom actions.
+	Payload nbt.Tag
+	// Template is the command template of dynamic/run_command, with $(key) macros.
+	Template string
+	// Additions are added to the input values in the payload of dynamic/custom actions.
+	Additions nbt.Compound
+}
+
+// Body is a dialog body element: *PlainMessageBody or *ItemBody.
+type Body interface {
+	BodyType() string
+}
+
+// PlainMessageBody is a multiline text label.
+type PlainMessageBody struct {
+	Contents ns.TextComponent
+	Width    int32
+}
+
+func (*PlainMessageBody) BodyType() string { return "minecraft:plain_message" }
+
+// ItemBody shows an item with an optional description.
+type ItemBody struct {
+	Item            ItemStack
+	Description     *PlainMessageBody
+	ShowDecorations bool
+	ShowTooltip     bool
+	Width, Height   int32
+}
+
+func (*ItemBody) BodyType() string { return "minecraft:item" }
+
+// ItemStack is an item of an item body, with its component patch as NBT.
+type ItemStack struct {
+	ID         string
+	Count      int32
+	Components nbt.Compound
+}
+
+// Input is a dialog input control: *TextInput, *BooleanInput, *SingleOptionInput
+// or *NumberRangeInput. Its value is sent under its key.
+type Input interface {
+	InputKey() string
+	InputType() string
+	// DefaultValue returns the initial value of the control.
+	DefaultValue() any
+}
+
+// TextInput is a text box. Its value is a string.
+type TextInput struct {
+	Key          string
+	Label        ns.TextComponent
+	LabelVisible bool
+	Width        int32
+	Initial      string
+	MaxLength    int32
+	// Multiline is nil for single line text boxes.
+	Multiline *Multiline
+}
+
+// Multiline are the options of multiline text boxes; zero values are unset.
+type Multiline struct {
+	MaxLines int32
+	Height   int32
+}
+
+func (in *TextInput) InputKey() string  { return in.Key }
+func (*TextInput) InputType() string    { return "minecraft:text" }
+func (in *TextInput) DefaultValue() any { return in.Initial }
+
+// BooleanInput is a checkbox. Its value is a bool.
+type BooleanInput struct {
+	Key     string
+	Label   ns.TextComponent
+	Initial bool
+	// OnTrue and OnFalse are the values substituted in command templates.
+	OnTrue, OnFalse string
+}
+
+func (in *BooleanInput) InputKey() string  { return in.Key }
+func (*BooleanInput) InputType() string    { return "minecraft:boolean" }
+func (in *BooleanInput) DefaultValue() any { return in.Initial }
+
+// SingleOptionInput is a button cycling through options. Its value is an option ID.
+type SingleOptionInput struct {
+	Key          string
+	Label        ns.TextComponent
+	LabelVisible bool
+	Width        int32
+	Options      []Option
+}
+
+// Option is an option of a single option input.
+type Option struct {
+	ID      string
+	Display *ns.TextComponent
+	Initial bool
+}
+
+func (in *SingleOptionInput) InputKey() string { return in.Key }
+func (*SingleOptionInput) InputType() string   { return "minecraft:single_option" }
+
+// DefaultValue returns the ID of the initial option, or of the first option if none is marked initial.
+func (in *SingleOptionInput) DefaultValue() any {
+	for _, o := range in.Options {
+		if o.Initial {
+			return o.ID
+		}
+	}
+	if len(in.Options) == 0 {
+		return ""
+	}
+	return in.Options[0].ID
+}
+
+// NumberRangeInput is a slider. Its value is a float32.
+type NumberRangeInput struct {
+	Key         string
+	Label       ns.TextComponent
+	LabelFormat string
+	Width       int32
+	Start, End  float32
+	// Step is the slider step, or 0 for a continuous slider.
+	Step float32
+	// Initial is the initial value, or nil for the middle of the range.
+	Initial *float32
+}
+
+func (in *NumberRangeInput) InputKey() string { return in.Key }
+func (*NumberRangeInput) InputType() string   { return "minecraft:number_range" }
+
+func (in *NumberRangeInput) DefaultValue() any {
+	if in.Initial != nil {
+		return *in.Initial
+	}
+	return (in.Start + in.End) / 2
+}
+
+// dialogRegistry is the registry of dialogs referenced by ID.
+const dialogRegistry = "minecraft:dialog"
+
+// Registry resolves dialog references against the minecraft:dialog registry
+// of a connection.
+type Registry struct {
+	ra      *registries.RegistryAccess
+	dialogs map[string]*Dialog
+}
+
+// NewRegistry returns a registry that resolves dialog IDs against the
+// minecraft:dialog registry of ra. Entries without data from ApplyRegistryData
+// come from a known pack and use the vanilla definitions.
+func NewRegistry(ra *registries.RegistryAccess) *Registry {
+	return &Registry{ra: ra, dialogs: make(map[string]*Dialog)}
+}
+
+// ApplyRegistryData records the dialog definitions the server sent. Other
+// registries are ignored; the entry order must still be applied to the
+// RegistryAccess.
+func (r *Registry) ApplyRegistryData(p *packets.S2CRegistryData) error {
+	if string(p.RegistryId) != dialogRegistry {
+		return nil
+	}
+	clear(r.dialogs)
+	for _, e := range p.Entries {
+		if !e.HasData {
+			continue
+		}
+		d, err := Parse(e.Data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", e.EntryId, err)
+		}
+		r.dialogs[string(e.EntryId)] = d
+	}
+	return nil
+}
+
+// Dialog returns a registered dialog by name, e.g. of a DialogEntry.
+func (r *Registry) Dialog(name string) (*Dialog, error) {
+	reg := r.ra.Lookup(dialogRegistry)
+	if reg == nil {
+		return nil, fmt.Errorf("dialogs: no %s registry", dialogRegistry)
+	}
+	if reg.Get(name) < 0 {
+		return nil, fmt.Errorf("dialogs: unknown dialog %q", name)
+	}
+	if d, ok := r.dialogs[name]; ok {
+		return d, nil
+	}
+	return Vanilla(name)
+}
+
+// FromHolder returns the dialog of an S2CShowDialogPlay holder.
+func (r *Registry) FromHolder(h packets.DialogHolder) (*Dialog, error) {
+	if h.IsInline {
+		return Parse(h.Value)
+	}
+	reg := r.ra.Lookup(dialogRegistry)
+	if reg == nil {
+		return nil, fmt.Errorf("dialogs: no %s registry", dialogRegistry)
+	}
+	name := reg.ByID(int32(h.ID))
+	if name == "" {
+		return nil, fmt.Errorf("dialogs: unknown dialog ID %d", h.ID)
+	}
+	return r.Dialog(name)
+}
+
+// Vanilla returns the vanilla dialog with the given name, e.g. "minecraft:server_links".
+func Vanilla(name string) (*Dialog, error) {
+	data, ok := registries.SynchronizedRegistryData[dialogRegistry][name]
+	if !ok {
+		return nil, fmt.Errorf("dialogs: unknown vanilla dialog %q", name)
+	}
+	return ParseJSON(data)
+}
+
+// ParseJSON parses a dialog definition in its JSON form, as found in data packs.
+func ParseJSON(data []byte) (*Dialog, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("dialogs: %w", err)
+	}
+	return Parse(jsonToNBT(v))
+}
+
+// jsonToNBT converts decoded JSON to the NBT the server would send.
+func jsonToNBT(v any) nbt.Tag {
+	switch v := v.(type) {
+	case map[string]any:
+		c := make(nbt.Compound, len(v))
+		for k, e := range v {
+			c[k] = jsonToNBT(e)
+		}
+		return c
+	case []any:
+		list := nbt.List{ElementType: nbt.TagEnd}
+		for _, e := range v {
+			tag := jsonToNBT(e)
+			list.ElementType = tag.ID()
+			list.Elements = append(list.Elements, tag)
+		}
+		return list
+	case string:
+		return nbt.String(v)
+	case bool:
+		if v {
+			return nbt.Byte(1)
+		}
+		return nbt.Byte(0)
+	case json.Number:
+		if i, err := v.Int64(); err == nil && i == int64(int32(i)) {
+			return nbt.Int(i)
+		}
+		f, _ := v.Float64()
+		return nbt.Double(f)
+	}
+	return nbt.End{}
+}
+
+// Parse parses a dialog definition from NBT, as sent in S2CShowDialog packets.
+func Parse(tag nbt.Tag) (*Dialog, error) {
+	c, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("dialogs: dialog is %T, not a compound", tag)
+	}
+	d := &Dialog{
+		Type:               identifier(str(c, "type", "")),
+		CanCloseWithEscape: boolean(c, "can_close_with_escape", true),
+		Pause:              boolean(c, "pause", true),
+		AfterAction:        str(c, "after_action", AfterActionClose),
+		Columns:            integer(c, "columns", 2),
+		ButtonWidth:        integer(c, "button_width", 150),
+	}
+	var err error
+	if d.Title, err = text(c, "title"); err != nil {
+		return nil, err
+	}
+	if d.ExternalTitle, err = optionalText(c, "external_title"); err != nil {
+		return nil, err
+	}
+	for _, e := range listOrSingle(c["body"]) {
+		body, err := parseBody(e)
+		if err != nil {
+			return nil, err
+		}
+		d.Body = append(d.Body, body)
+	}
+	for _, e := range listOrSingle(c["inputs"]) {
+		input, err := parseInput(e)
+		if err != nil {
+			return nil, err
+		}
+		d.Inputs = append(d.Inputs, input)
+	}
+
+	switch d.Type {
+	case TypeNotice:
+		if c["action"] == nil {
+			d.Action = &ActionButton{Label: ns.NewTranslateComponent("gui.ok"), Width: 150}
+		} else if d.Action, err = parseButton(c["action"]); err != nil {
+			return nil, err
+		}
+	case TypeConfirmation:
+		if d.Yes, err = parseButton(c["yes"]); err != nil {
+			return nil, err
+		}
+		if d.No, err = parseButton(c["no"]); err != nil {
+			return nil, err
+		}
+	case TypeMultiAction:
+		for _, e := range listOrSingle(c["actions"]) {
+			b, err := parseButton(e)
+			if err != nil {
+				return nil, err
+			}
+			d.Actions = append(d.Actions, *b)
+		}
+		if len(d.Actions) == 0 {
+			return nil, fmt.Errorf("dialogs: multi-action dialog without actions")
+		}
+	case TypeServerLinks:
+	case TypeDialogList:
+		if s, ok := c["dialogs"].(nbt.String); ok && strings.HasPrefix(string(s), "#") {
+			d.DialogTag = identifier(strings.TrimPrefix(string(s), "#"))
+			break
+		}
+		for _, e := range listOrSingle(c["dialogs"]) {
+			entry, err := parseDialogEntry(e)
+			if err != nil {
+				return nil, err
+			}
+			d.Dialogs = append(d.Dialogs, entry)
+		}
+	default:
+		return nil, fmt.Errorf("dialogs: unknown dialog type %q", d.Type)
+	}
+	if c["exit_action"] != nil && d.Type != TypeNotice && d.Type != TypeConfirmation {
+		if d.ExitAction, err = parseButton(c["exit_action"]); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+func parseDialogEntry(tag nbt.Tag) (DialogEntry, error) {
+	if s, ok := tag.(nbt.String); ok {
+		return DialogEntry{Name: identifier(string(s))}, nil
+	}
+	inline, err := Parse(tag)
+	return DialogEntry{Inline: inline}, err
+}
+
+func parseButton(tag nbt.Tag) (*ActionButton, error) {
+	c, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("dialogs: action button is %T, not a compound", tag)
+	}
+	b := &ActionButton{Width: integer(c, "width", 150)}
+	var err error
+	if b.Label, err = text(c, "label"); err != nil {
+		return nil, err
+	}
+	if b.Tooltip, err = optionalText(c, "tooltip"); err != nil {
+		return nil, err
+	}
+	if a, ok := c["action"].(nbt.Compound); ok {
+		if b.Action, err = parseAction(a); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func parseAction(c nbt.Compound) (*Action, error) {
+	a := &Action{
+		Type:     identifier(str(c, "type", "")),
+		URL:      str(c, "url", ""),
+		Command:  str(c, "command", ""),
+		Page:     integer(c, "page", 0),
+		Value:    str(c, "value", ""),
+		ID:       identifier(str(c, "id", "")),
+		Payload:  c["payload"],
+		Template: str(c, "template", ""),
+	}
+	if additions, ok := c["additions"].(nbt.Compound); ok {
+		a.Additions = additions
+	}
+	if c["dialog"] != nil {
+		var err error
+		if a.Dialog, err = parseDialogEntry(c["dialog"]); err != nil {
+			return nil, err
+		}
+	}
+	if a.Type == "" {
+		return nil, fmt.Errorf("dialogs: action without type")
+	}
+	return a, nil
+}
+
+func parseBody(tag nbt.Tag) (Body, error) {
+	c, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("dialogs: body element is %T, not a compound", tag)
+	}
+	switch t := identifier(str(c, "type", "")); t {
+	case "minecraft:plain_message":
+		return parsePlainMessage(c)
+	case "minecraft:item":
+		item, ok := c["item"].(nbt.Compound)
+		if !ok {
+			return nil, fmt.Errorf("dialogs: item body without item")
+		}
+		b := &ItemBody{
+			Item:            ItemStack{ID: identifier(str(item, "id", "")), Count: integer(item, "count", 1)},
+			ShowDecorations: boolean(c, "show_decorations", true),
+			ShowTooltip:     boolean(c, "show_tooltip", true),
+			Width:           integer(c, "width", 16),
+			Height:          integer(c, "height", 16),
+		}
+		if components, ok := item["components"].(nbt.Compound); ok {
+			b.Item.Components = components
+		}
+		if desc := c["description"]; desc != nil {
+			// the description is a plain message body or just its contents
+			if dc, ok := desc.(nbt.Compound); ok && dc["contents"] != nil {
+				var err error
+				if b.Description, err = parsePlainMessage(dc); err != nil {
+					return nil, err
+				}
+			} else {
+				var contents ns.TextComponent
+				if err := contents.UnmarshalNBT(desc); err != nil {
+					return nil, fmt.Errorf("dialogs: item description: %w", err)
+				}
+				b.Description = &PlainMessageBody{Contents: contents, Width: 200}
+			}
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("dialogs: unknown body type %q", t)
+	}
+}
+
+func parsePlainMessage(c nbt.Compound) (*PlainMessageBody, error) {
+	contents, err := text(c, "contents")
+	return &PlainMessageBody{Contents: contents, Width: integer(c, "width", 200)}, err
+}
+
+func parseInput(tag nbt.Tag) (Input, error) {
+	c, ok := tag.(nbt.Compound)
+	if !ok {
+		return nil, fmt.Errorf("dialogs: input is %T, not a compound", tag)
+	}
+	key := str(c, "key", "")
+	if key == "" {
+		return nil, fmt.Errorf("dialogs: input without key")
+	}
+	label, err := text(c, "label")
+	if err != nil {
+		return nil, err
+	}
+	switch t := identifier(str(c, "type", "")); t {
+	case "minecraft:text":
+		in := &TextInput{
+			Key:          key,
+			Label:        label,
+			LabelVisible: boolean(c, "label_visible", true),
+			Width:        integer(c, "width", 200),
+			Initial:      str(c, "initial", ""),
+			MaxLength:    integer(c, "max_length", 32),
+		}
+		if m, ok := c["multiline"].(nbt.Compound); ok {
+			in.Multiline = &Multiline{MaxLines: integer(m, "max_lines", 0), Height: integer(m, "height", 0)}
+		}
+		return in, nil
+	case "minecraft:boolean":
+		return &BooleanInput{
+			Key:     key,
+			Label:   label,
+			Initial: boolean(c, "initial", false),
+			OnTrue:  str(c, "on_true", "true"),
+			OnFalse: str(c, "on_false", "false"),
+		}, nil
+	case "minecraft:single_option":
+		in := &SingleOptionInput{
+			Key:          key,
+			Label:        label,
+			LabelVisible: boolean(c, "label_visible", true),
+			Width:        integer(c, "width", 200),
+		}
+		for _, e := range listOrSingle(c["options"]) {
+			if id, ok := e.(nbt.String); ok {
+				in.Options = append(in.Options, Option{ID: string(id)})
+				continue
+			}
+			oc, ok := e.(nbt.Compound)
+			if !ok {
+				return nil, fmt.Errorf("dialogs: option is %T, not a compound", e)
+			}
+			o := Option{ID: str(oc, "id", ""), Initial: boolean(oc, "initial", false)}
+			if o.Display, err = optionalText(oc, "display"); err != nil {
+				return nil, err
+			}
+			in.Options = append(in.Options, o)
+		}
+		if len(in.Options) == 0 {
+			return nil, fmt.Errorf("dialogs: single option input %q without options", key)
+		}
+		return in, nil
+	case "minecraft:number_range":
+		in := &NumberRangeInput{
+			Key:         key,
+			Label:       label,
+			LabelFormat: str(c, "label_format", "options.generic_value"),
+			Width:       integer(c, "width", 200),
+			Start:       float(c, "start"),
+			End:         float(c, "end"),
+			Step:        float(c, "step"),
+		}
+		if _, ok := c["initial"]; ok {
+			initial := float(c, "initial")
+			in.Initial = &initial
+		}
+		return in, nil
+	default:
+		return nil, fmt.Errorf("dialogs: unknown input type %q", t)
+	}
+}
+
+// identifier adds the default namespace to an identifier without one.
+func identifier(s string) string {
+	if s == "" || strings.Contains(s, ":") {
+		return s
+	}
+	return "minecraft:" + s
+}
+
+// listOrSingle returns the elements of a list, or a single element as a list.
+func listOrSingle(tag nbt.Tag) []nbt.Tag {
+	switch t := tag.(type) {
+	case nil:
+		return nil
+	case nbt.List:
+		return t.Elements
+	}
+	return []nbt.Tag{tag}
+}
+
+func str(c nbt.Compound, key, def string) string {
+	if s, ok := c[key].(nbt.String); ok {
+		return string(s)
+	}
+	return def
+}
+
+func boolean(c nbt.Compound, key string, def bool) bool {
+	if b, ok := c[key].(nbt.Byte); ok {
+		return b != 0
+	}
+	return def
+}
+
+func integer(c nbt.Compound, key string, def int32) int32 {
+	switch n := c[key].(type) {
+	case nbt.Byte:
+		return int32(n)
+	case nbt.Short:
+		return int32(n)
+	case nbt.Int:
+		return int32(n)
+	case nbt.Long:
+		return int32(n)
+	}
+	return def
+}
+
+func float(c nbt.Compound, key string) float32 {
+	switch n := c[key].(type) {
+	case nbt.Float:
+		return float32(n)
+	case nbt.Double:
+		return float32(n)
+	}
+	return float32(integer(c, key, 0))
+}
+
+func text(c nbt.Compound, key string) (ns.TextComponent, error) {
+	var tc ns.TextComponent
+	if c[key] == nil {
+		return tc, fmt.Errorf("dialogs: missing %s", key)
+	}
+	if err := tc.UnmarshalNBT(c[key]); err != nil {
+		return tc, fmt.Errorf("dialogs: %s: %w", key, err)
+	}
+	return tc, nil
+}
+
+func optionalText(c nbt.Compound, key string) (*ns.TextComponent, error) {
+	if c[key] == nil {
+		return nil, nil
+	}
+	tc, err := text(c, key)
+	return &tc, err
+}
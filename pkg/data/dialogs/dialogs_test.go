@@ -0,0 +1,199 @@
+package dialogs_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/dialogs"
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func showDialog(t *testing.T, dialog nbt.Compound) *dialogs.Dialog {
+	t.Helper()
+	h := packets.DialogHolder{IDOrX: ns.NewInlineValue[nbt.Tag](dialog)}
+	d, err := dialogs.NewRegistry(registries.NewRegistryAccess()).FromHolder(h)
+	require.NoError(t, err)
+	return d
+}
+
+func TestNoticeDefaults(t *testing.T) {
+	d := showDialog(t, nbt.Compound{
+		"type":  nbt.String("notice"),
+		"title": nbt.String("Hello"),
+		"body":  nbt.Compound{"type": nbt.String("plain_message"), "contents": nbt.String("Welcome!")},
+	})
+
+	assert.Equal(t, dialogs.TypeNotice, d.Type)
+	assert.Equal(t, "Hello", d.Title.Text)
+	assert.True(t, d.CanCloseWithEscape)
+	assert.True(t, d.Pause)
+	assert.Equal(t, dialogs.AfterActionClose, d.AfterAction)
+	require.Len(t, d.Body, 1)
+	body := d.Body[0].(*dialogs.PlainMessageBody)
+	assert.Equal(t, "Welcome!", body.Contents.Text)
+	assert.Equal(t, int32(200), body.Width)
+	require.NotNil(t, d.Action)
+	assert.Equal(t, "gui.ok", d.Action.Label.Translate)
+	assert.Nil(t, d.Action.Action)
+}
+
+func TestVanillaServerLinks(t *testing.T) {
+	d, err := dialogs.Vanilla("minecraft:server_links")
+	require.NoError(t, err)
+
+	assert.Equal(t, dialogs.TypeServerLinks, d.Type)
+	assert.Equal(t, "menu.server_links.title", d.Title.Translate)
+	require.NotNil(t, d.ExternalTitle)
+	assert.Equal(t, "menu.server_links", d.ExternalTitle.Translate)
+	assert.Equal(t, int32(1), d.Columns)
+	assert.Equal(t, int32(310), d.ButtonWidth)
+	require.NotNil(t, d.ExitAction)
+	assert.Equal(t, int32(200), d.ExitAction.Width)
+
+	list, err := dialogs.Vanilla("minecraft:quick_actions")
+	require.NoError(t, err)
+	assert.Equal(t, dialogs.TypeDialogList, list.Type)
+	assert.Equal(t, "minecraft:quick_actions", list.DialogTag)
+}
+
+func TestFromHolderReference(t *testing.T) {
+	ra := registries.NewRegistryAccess()
+	_, err := ra.ApplyRegistryData("minecraft:dialog", []string{"minecraft:custom_options", "minecraft:server_links", "custom:rules"})
+	require.NoError(t, err)
+	r := dialogs.NewRegistry(ra)
+	require.NoError(t, r.ApplyRegistryData(&packets.S2CRegistryData{
+		RegistryId: "minecraft:dialog",
+		Entries: []packets.RegistryEntry{
+			{EntryId: "minecraft:custom_options"},
+			{EntryId: "minecraft:server_links"},
+			{EntryId: "custom:rules", HasData: true, Data: nbt.Compound{
+				"type":  nbt.String("minecraft:notice"),
+				"title": nbt.String("Rules"),
+			}},
+		},
+	}))
+
+	// known pack entries use the vanilla definitions
+	d, err := r.FromHolder(packets.DialogHolder{IDOrX: ns.NewIDRef[nbt.Tag](1)})
+	require.NoError(t, err)
+	assert.Equal(t, dialogs.TypeServerLinks, d.Type)
+
+	d, err = r.FromHolder(packets.DialogHolder{IDOrX: ns.NewIDRef[nbt.Tag](2)})
+	require.NoError(t, err)
+	assert.Equal(t, dialogs.TypeNotice, d.Type)
+	assert.Equal(t, "Rules", d.Title.Text)
+
+	_, err = r.FromHolder(packets.DialogHolder{IDOrX: ns.NewIDRef[nbt.Tag](5)})
+	assert.Error(t, err)
+	_, err = r.Dialog("minecraft:quick_actions")
+	assert.Error(t, err, "not in the server's registry")
+}
+
+func TestConfirmationCustomAction(t *testing.T) {
+	d := showDialog(t, nbt.Compound{
+		"type":  nbt.String("minecraft:confirmation"),
+		"title": nbt.String("Sure?"),
+		"yes": nbt.Compound{
+			"label":  nbt.String("Yes"),
+			"action": nbt.Compound{"type": nbt.String("custom"), "id": nbt.String("example:confirm"), "payload": nbt.Compound{"ok": nbt.Byte(1)}},
+		},
+		"no": nbt.Compound{"label": nbt.String("No")},
+	})
+
+	require.NotNil(t, d.Yes)
+	require.NotNil(t, d.No)
+	assert.Len(t, d.Buttons(), 2)
+
+	p, err := d.CustomClickActionPlay(d.Yes.Action, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ns.Identifier("example:confirm"), p.Id)
+	assert.Equal(t, nbt.Compound{"ok": nbt.Byte(1)}, p.Payload)
+}
+
+func formDialog(t *testing.T) *dialogs.Dialog {
+	t.Helper()
+	return showDialog(t, nbt.Compound{
+		"type":  nbt.String("multi_action"),
+		"title": nbt.String("Form"),
+		"inputs": nbt.List{ElementType: nbt.TagCompound, Elements: []nbt.Tag{
+			nbt.Compound{"type": nbt.String("text"), "key": nbt.String("name"), "label": nbt.String("Name"), "max_length": nbt.Int(8)},
+			nbt.Compound{"type": nbt.String("boolean"), "key": nbt.String("pvp"), "label": nbt.String("PvP"), "on_true": nbt.String("on")},
+			nbt.Compound{"type": nbt.String("single_option"), "key": nbt.String("mode"), "label": nbt.String("Mode"), "options": nbt.List{
+				ElementType: nbt.TagCompound,
+				Elements: []nbt.Tag{
+					nbt.Compound{"id": nbt.String("easy")},
+					nbt.Compound{"id": nbt.String("hard"), "initial": nbt.Byte(1), "display": nbt.String("Hard")},
+				},
+			}},
+			nbt.Compound{"type": nbt.String("number_range"), "key": nbt.String("size"), "label": nbt.String("Size"), "start": nbt.Float(1), "end": nbt.Float(9), "step": nbt.Float(1)},
+		}},
+		"actions": nbt.List{ElementType: nbt.TagCompound, Elements: []nbt.Tag{
+			nbt.Compound{"label": nbt.String("Submit"), "action": nbt.Compound{
+				"type":      nbt.String("dynamic/custom"),
+				"id":        nbt.String("example:submit"),
+				"additions": nbt.Compound{"form": nbt.String("settings"), "name": nbt.String("overridden")},
+			}},
+			nbt.Compound{"label": nbt.String("Run"), "action": nbt.Compound{
+				"type":     nbt.String("dynamic/run_command"),
+				"template": nbt.String("setup $(name) $(pvp) $(mode) $(size)"),
+			}},
+		}},
+	})
+}
+
+func TestFormInputs(t *testing.T) {
+	d := formDialog(t)
+
+	require.Len(t, d.Inputs, 4)
+	text := d.Input("name").(*dialogs.TextInput)
+	assert.Equal(t, int32(8), text.MaxLength)
+	assert.True(t, text.LabelVisible)
+	boolean := d.Input("pvp").(*dialogs.BooleanInput)
+	assert.Equal(t, "on", boolean.OnTrue)
+	assert.Equal(t, "false", boolean.OnFalse)
+	assert.Equal(t, int32(2), d.Columns)
+
+	assert.Equal(t, dialogs.Values{"name": "", "pvp": false, "mode": "hard", "size": float32(5)}, d.DefaultValues())
+}
+
+func TestDynamicCustomResponse(t *testing.T) {
+	d := formDialog(t)
+
+	p, err := d.CustomClickActionConfiguration(d.Actions[0].Action, dialogs.Values{"name": "Steve", "pvp": true, "size": float32(3)})
+	require.NoError(t, err)
+	assert.Equal(t, ns.Identifier("example:submit"), p.Id)
+	assert.Equal(t, nbt.Compound{
+		"form": nbt.String("settings"),
+		"name": nbt.String("Steve"),
+		"pvp":  nbt.Byte(1),
+		"mode": nbt.String("hard"),
+		"size": nbt.Float(3),
+	}, p.Payload)
+
+	_, err = d.CustomClickActionPlay(d.Actions[0].Action, dialogs.Values{"name": "much too long"})
+	assert.Error(t, err)
+	_, err = d.CustomClickActionPlay(d.Actions[0].Action, dialogs.Values{"mode": "medium"})
+	assert.Error(t, err)
+	_, err = d.CustomClickActionPlay(d.Actions[0].Action, dialogs.Values{"size": float32(10)})
+	assert.Error(t, err)
+	_, err = d.CustomClickActionPlay(d.Actions[0].Action, dialogs.Values{"unknown": "x"})
+	assert.Error(t, err)
+	_, err = d.CustomClickActionPlay(d.Actions[1].Action, nil)
+	assert.Error(t, err)
+}
+
+func TestDynamicRunCommand(t *testing.T) {
+	d := formDialog(t)
+
+	cmd, err := d.Command(d.Actions[1].Action, dialogs.Values{"name": "Alex", "mode": "easy", "size": float32(2.5)})
+	require.NoError(t, err)
+	assert.Equal(t, "setup Alex false easy 2.5", cmd)
+
+	cmd, err = d.Command(d.Actions[1].Action, dialogs.Values{"pvp": true})
+	require.NoError(t, err)
+	assert.Equal(t, "setup  on hard 5", cmd)
+}
@@ -0,0 +1,188 @@
+package dialogs
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Values are the filled-in values of dialog inputs, by key: a string for text
+// inputs, a bool for boolean inputs, an option ID for single option inputs and a
+// float32 for number range inputs.
+type Values map[string]any
+
+// DefaultValues returns the initial values of the dialog inputs.
+func (d *Dialog) DefaultValues() Values {
+	values := make(Values, len(d.Inputs))
+	for _, in := range d.Inputs {
+		values[in.InputKey()] = in.DefaultValue()
+	}
+	return values
+}
+
+// inputValue is an input value in both forms sent by the client.
+type inputValue struct {
+	tag      nbt.Tag
+	template string
+}
+
+// resolve validates values against the inputs, filling in missing values with defaults.
+func (d *Dialog) resolve(values Values) (map[string]inputValue, error) {
+	resolved := make(map[string]inputValue, len(d.Inputs))
+	for _, in := range d.Inputs {
+		v, ok := values[in.InputKey()]
+		if !ok {
+			v = in.DefaultValue()
+		}
+		iv, err := resolveInput(in, v)
+		if err != nil {
+			return nil, fmt.Errorf("dialogs: input %q: %w", in.InputKey(), err)
+		}
+		resolved[in.InputKey()] = iv
+	}
+	for key := range values {
+		if d.Input(key) == nil {
+			return nil, fmt.Errorf("dialogs: unknown input %q", key)
+		}
+	}
+	return resolved, nil
+}
+
+func resolveInput(in Input, v any) (inputValue, error) {
+	switch in := in.(type) {
+	case *TextInput:
+		s, ok := v.(string)
+		if !ok {
+			return inputValue{}, fmt.Errorf("value is %T, not a string", v)
+		}
+		if in.MaxLength > 0 && utf8.RuneCountInString(s) > int(in.MaxLength) {
+			return inputValue{}, fmt.Errorf("value longer than %d characters", in.MaxLength)
+		}
+		return inputValue{tag: nbt.String(s), template: s}, nil
+	case *BooleanInput:
+		b, ok := v.(bool)
+		if !ok {
+			return inputValue{}, fmt.Errorf("value is %T, not a bool", v)
+		}
+		if b {
+			return inputValue{tag: nbt.Byte(1), template: in.OnTrue}, nil
+		}
+		return inputValue{tag: nbt.Byte(0), template: in.OnFalse}, nil
+	case *SingleOptionInput:
+		id, ok := v.(string)
+		if !ok {
+			return inputValue{}, fmt.Errorf("value is %T, not an option ID", v)
+		}
+		for _, o := range in.Options {
+			if o.ID == id {
+				return inputValue{tag: nbt.String(id), template: id}, nil
+			}
+		}
+		return inputValue{}, fmt.Errorf("unknown option %q", id)
+	case *NumberRangeInput:
+		var f float32
+		switch n := v.(type) {
+		case float32:
+			f = n
+		case float64:
+			f = float32(n)
+		case int:
+			f = float32(n)
+		default:
+			return inputValue{}, fmt.Errorf("value is %T, not a number", v)
+		}
+		if f < min(in.Start, in.End) || f > max(in.Start, in.End) {
+			return inputValue{}, fmt.Errorf("value %v outside [%v, %v]", f, in.Start, in.End)
+		}
+		return inputValue{tag: nbt.Float(f), template: formatNumber(f)}, nil
+	}
+	return inputValue{}, fmt.Errorf("unsupported input %T", in)
+}
+
+// formatNumber formats a slider value like vanilla: whole numbers without a fraction.
+func formatNumber(f float32) string {
+	if i := int32(f); float32(i) == f {
+		return strconv.Itoa(int(i))
+	}
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
+
+var macroPattern = regexp.MustCompile(`\$\(([a-zA-Z0-9_]+)\)`)
+
+// Command returns the command run by a run_command or dynamic/run_command action,
+// with the $(key) macros of the template replaced by the input values.
+func (d *Dialog) Command(a *Action, values Values) (string, error) {
+	switch a.Type {
+	case ActionRunCommand:
+		return a.Command, nil
+	case ActionDynamicRunCommand:
+	default:
+		return "", fmt.Errorf("dialogs: %s action does not run a command", a.Type)
+	}
+	resolved, err := d.resolve(values)
+	if err != nil {
+		return "", err
+	}
+	var missing string
+	cmd := macroPattern.ReplaceAllStringFunc(a.Template, func(m string) string {
+		key := m[2 : len(m)-1]
+		v, ok := resolved[key]
+		if !ok && missing == "" {
+			missing = key
+		}
+		return v.template
+	})
+	if missing != "" {
+		return "", fmt.Errorf("dialogs: template references unknown input %q", missing)
+	}
+	return cmd, nil
+}
+
+// CustomPayload returns the ID and payload sent for a custom or dynamic/custom action.
+// Dynamic payloads hold the additions and the input values, which take precedence.
+func (d *Dialog) CustomPayload(a *Action, values Values) (string, nbt.Tag, error) {
+	switch a.Type {
+	case ActionCustom:
+		return a.ID, a.Payload, nil
+	case ActionDynamicCustom:
+	default:
+		return "", nil, fmt.Errorf("dialogs: %s action has no custom payload", a.Type)
+	}
+	resolved, err := d.resolve(values)
+	if err != nil {
+		return "", nil, err
+	}
+	payload := make(nbt.Compound, len(a.Additions)+len(resolved))
+	maps.Copy(payload, a.Additions)
+	for key, v := range resolved {
+		payload[key] = v.tag
+	}
+	return a.ID, payload, nil
+}
+
+// CustomClickActionPlay returns the response to a custom or dynamic/custom action
+// in the play state.
+func (d *Dialog) CustomClickActionPlay(a *Action, values Values) (*packets.C2SCustomClickActionPlay, error) {
+	id, payload, err := d.CustomPayload(a, values)
+	if err != nil {
+		return nil, err
+	}
+	return &packets.C2SCustomClickActionPlay{Id: ns.Identifier(id), Payload: payload}, nil
+}
+
+// CustomClickActionConfiguration returns the response to a custom or dynamic/custom
+// action in the configuration state.
+func (d *Dialog) CustomClickActionConfiguration(a *Action, values Values) (*packets.C2SCustomClickActionConfiguration, error) {
+	id, payload, err := d.CustomPayload(a, values)
+	if err != nil {
+		return nil, err
+	}
+	return &packets.C2SCustomClickActionConfiguration{Id: ns.Identifier(id), Payload: payload}, nil
+}
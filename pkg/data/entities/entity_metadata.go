@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-mclib/data/pkg/data/items"
 	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
@@ -366,16 +367,11 @@ func readSerializerValue(buf *ns.PacketBuffer, serializerID int32) ([]byte, erro
 
 // copyParticle copies a particle from buf to w.
 func copyParticle(buf *ns.PacketBuffer, w *ns.PacketBuffer) error {
-	particleType, err := buf.ReadVarInt()
+	p, err := ReadParticle(buf)
 	if err != nil {
 		return err
 	}
-	w.WriteVarInt(particleType)
-
-	// particle data varies by type - for now just copy the type ID
-	// full particle data parsing would require the particle registry
-	// TODO: implement Particle type, maybe in pkg/data/misc/particles_gen.go or something?
-	return nil
+	return p.Write(w)
 }
 
 // SerializerName returns the name of a serializer by ID, or empty string if unknown.
@@ -507,7 +503,21 @@ func FormatMetadataValueIndented(serializerID int32, data []byte, indent string)
 		}
 		return items.FormatSlotForDisplay(slot, indent)
 
-	case "nbt", "optional_nbt", "particle", "particle_list",
+	case "particle":
+		if p, err := ReadParticle(buf); err == nil {
+			return p.String()
+		}
+
+	case "particle_list":
+		if particles, err := ReadParticles(buf); err == nil {
+			s := make([]string, len(particles))
+			for i, p := range particles {
+				s[i] = p.String()
+			}
+			return "[" + strings.Join(s, ", ") + "]"
+		}
+
+	case "nbt", "optional_nbt",
 		"optional_global_pos", "id_or_inline", "resolvable_profile":
 		// complex types - show hex for now
 		return fmt.Sprintf("0x%x", data)
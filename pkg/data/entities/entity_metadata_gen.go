@@ -240,6 +240,7 @@ type AgeableMobMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -257,7 +258,7 @@ type AgeableMobMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -278,6 +279,7 @@ type ArmorStandMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -300,7 +302,7 @@ type ArmorStandMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -354,6 +356,7 @@ type AvatarMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -371,7 +374,7 @@ type AvatarMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -392,6 +395,7 @@ type BatMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -409,7 +413,7 @@ type BatMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -430,6 +434,7 @@ type BlazeMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -447,7 +452,7 @@ type BlazeMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -502,6 +507,7 @@ type CatMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -524,7 +530,7 @@ type CatMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -550,6 +556,7 @@ type ChickenMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -569,7 +576,7 @@ type ChickenMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -592,6 +599,7 @@ type CowMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -611,7 +619,7 @@ type CowMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -634,6 +642,7 @@ type CreeperMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -653,7 +662,7 @@ type CreeperMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -676,6 +685,7 @@ type EnderDragonMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -693,7 +703,7 @@ type EnderDragonMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -714,6 +724,7 @@ type EndermanMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -732,7 +743,7 @@ type EndermanMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -846,6 +857,7 @@ type GhastMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -863,7 +875,7 @@ type GhastMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -932,6 +944,7 @@ type LivingEntityMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -947,7 +960,7 @@ type LivingEntityMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1000,6 +1013,7 @@ type MobMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1016,7 +1030,7 @@ type MobMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1036,6 +1050,7 @@ type PigMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1056,7 +1071,7 @@ type PigMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1080,6 +1095,7 @@ type PlayerMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1099,7 +1115,7 @@ type PlayerMetadata struct {
 	TicksFrozen         int32
 	LivingFlags         byte
 	Health              float32
-	EffectParticles     []byte // passthrough
+	EffectParticles     []Particle
 	EffectAmbient       bool
 	ArrowCount          int32
 	StingerCount        int32
@@ -1124,6 +1140,7 @@ type SheepMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1142,7 +1159,7 @@ type SheepMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1164,6 +1181,7 @@ type SkeletonMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1181,7 +1199,7 @@ type SkeletonMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1202,6 +1220,7 @@ type SlimeMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1219,7 +1238,7 @@ type SlimeMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1240,6 +1259,7 @@ type SpiderMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1257,7 +1277,7 @@ type SpiderMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1278,6 +1298,7 @@ type VillagerMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1295,7 +1316,7 @@ type VillagerMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1317,6 +1338,7 @@ type WitherMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1337,7 +1359,7 @@ type WitherMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1361,6 +1383,7 @@ type WolfMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1383,7 +1406,7 @@ type WolfMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1409,6 +1432,7 @@ type ZombieMetadata struct {
 	HasTicksFrozen       bool
 	HasLivingFlags       bool
 	HasHealth            bool
+	HasEffectParticles   bool
 	HasEffectAmbient     bool
 	HasArrowCount        bool
 	HasStingerCount      bool
@@ -1428,7 +1452,7 @@ type ZombieMetadata struct {
 	TicksFrozen       int32
 	LivingFlags       byte
 	Health            float32
-	EffectParticles   []byte // passthrough
+	EffectParticles   []Particle
 	EffectAmbient     bool
 	ArrowCount        int32
 	StingerCount      int32
@@ -1460,7 +1484,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1479,7 +1503,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1516,7 +1540,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1535,7 +1559,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1554,7 +1578,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1589,7 +1613,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1613,7 +1637,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1634,7 +1658,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1655,7 +1679,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1676,7 +1700,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1695,7 +1719,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1758,7 +1782,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1800,7 +1824,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1833,7 +1857,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1851,7 +1875,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1873,7 +1897,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1896,7 +1920,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1916,7 +1940,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1935,7 +1959,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1954,7 +1978,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1973,7 +1997,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -1993,7 +2017,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -2015,7 +2039,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -2039,7 +2063,7 @@ var entityMetadataFields = map[string][]FieldDef{
 		{Index: 7, Serializer: 1, Name: "TicksFrozen", Passthrough: false},
 		{Index: 8, Serializer: 0, Name: "LivingFlags", Passthrough: false},
 		{Index: 9, Serializer: 3, Name: "Health", Passthrough: false},
-		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: true},
+		{Index: 10, Serializer: 17, Name: "EffectParticles", Passthrough: false},
 		{Index: 11, Serializer: 8, Name: "EffectAmbient", Passthrough: false},
 		{Index: 12, Serializer: 1, Name: "ArrowCount", Passthrough: false},
 		{Index: 13, Serializer: 1, Name: "StingerCount", Passthrough: false},
@@ -0,0 +1,444 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/data/registries"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// Particle is a particle of the minecraft:particle_type registry with its options,
+// as sent in S2CLevelParticles and the PARTICLE(S) metadata serializers.
+type Particle struct {
+	// Type is the registry entry, e.g. "minecraft:dust".
+	Type string
+	// Options are the type-specific options, or nil for particle types without options.
+	Options ParticleOptions
+}
+
+// ParticleOptions are the options of a particle type, e.g. *DustParticleOptions.
+type ParticleOptions interface {
+	Read(buf *ns.PacketBuffer) error
+	Write(buf *ns.PacketBuffer) error
+}
+
+// NewParticle returns a particle of the given type with zero-valued options.
+func NewParticle(particleType string) Particle {
+	return Particle{Type: particleType, Options: newParticleOptions(particleType)}
+}
+
+// newParticleOptions returns zero-valued options for a particle type,
+// or nil if the type has no options.
+func newParticleOptions(particleType string) ParticleOptions {
+	switch particleType {
+	case "minecraft:block", "minecraft:block_marker", "minecraft:falling_dust",
+		"minecraft:dust_pillar", "minecraft:block_crumble":
+		return &BlockParticleOptions{}
+	case "minecraft:dust":
+		return &DustParticleOptions{}
+	case "minecraft:dust_color_transition":
+		return &DustColorTransitionOptions{}
+	case "minecraft:entity_effect", "minecraft:tinted_leaves", "minecraft:flash":
+		return &ColorParticleOptions{}
+	case "minecraft:effect", "minecraft:instant_effect":
+		return &SpellParticleOptions{}
+	case "minecraft:dragon_breath":
+		return &PowerParticleOptions{}
+	case "minecraft:item":
+		return &ItemParticleOptions{}
+	case "minecraft:vibration":
+		return &VibrationParticleOptions{}
+	case "minecraft:trail":
+		return &TrailParticleOptions{}
+	case "minecraft:shriek":
+		return &ShriekParticleOptions{}
+	case "minecraft:sculk_charge":
+		return &SculkChargeParticleOptions{}
+	}
+	return nil
+}
+
+// ReadParticle reads a type-prefixed particle.
+func ReadParticle(buf *ns.PacketBuffer) (Particle, error) {
+	var p Particle
+	err := p.Read(buf)
+	return p, err
+}
+
+// WriteParticle writes a type-prefixed particle.
+func WriteParticle(buf *ns.PacketBuffer, p Particle) error {
+	return p.Write(buf)
+}
+
+// ReadParticles reads a length-prefixed particle list.
+func ReadParticles(buf *ns.PacketBuffer) ([]Particle, error) {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("negative particle count %d", count)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	particles := make([]Particle, count)
+	for i := range particles {
+		if particles[i], err = ReadParticle(buf); err != nil {
+			return nil, err
+		}
+	}
+	return particles, nil
+}
+
+// WriteParticles writes a length-prefixed particle list.
+func WriteParticles(buf *ns.PacketBuffer, particles []Particle) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(particles))); err != nil {
+		return err
+	}
+	for _, p := range particles {
+		if err := p.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Particle) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	p.Type = registries.ParticleType.ByID(int32(id))
+	if p.Type == "" {
+		return fmt.Errorf("unknown particle type %d", id)
+	}
+	p.Options = newParticleOptions(p.Type)
+	if p.Options == nil {
+		return nil
+	}
+	return p.Options.Read(buf)
+}
+
+func (p *Particle) Write(buf *ns.PacketBuffer) error {
+	id := registries.ParticleType.Get(p.Type)
+	if id < 0 {
+		return fmt.Errorf("unknown particle type %q", p.Type)
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	if p.Options == nil {
+		if newParticleOptions(p.Type) != nil {
+			return fmt.Errorf("particle %s requires options", p.Type)
+		}
+		return nil
+	}
+	return p.Options.Write(buf)
+}
+
+// String returns the particle type with its options, e.g. "minecraft:dust{Color:16711680 Scale:1}".
+func (p Particle) String() string {
+	if p.Options == nil {
+		return p.Type
+	}
+	s := fmt.Sprintf("%+v", p.Options)
+	s = strings.TrimPrefix(s, "&")
+	return p.Type + s
+}
+
+// BlockParticleOptions are the options of block, block_marker, falling_dust,
+// dust_pillar and block_crumble particles.
+type BlockParticleOptions struct {
+	BlockState int32
+}
+
+func (o *BlockParticleOptions) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	o.BlockState = int32(v)
+	return err
+}
+
+func (o *BlockParticleOptions) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(ns.VarInt(o.BlockState))
+}
+
+// DustParticleOptions are the options of dust particles.
+type DustParticleOptions struct {
+	// Color is the RGB color, e.g. 0xff0000 for red.
+	Color int32
+	Scale float32
+}
+
+func (o *DustParticleOptions) Read(buf *ns.PacketBuffer) error {
+	color, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	scale, err := buf.ReadFloat32()
+	o.Color, o.Scale = int32(color), float32(scale)
+	return err
+}
+
+func (o *DustParticleOptions) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt32(ns.Int32(o.Color)); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(ns.Float32(o.Scale))
+}
+
+// DustColorTransitionOptions are the options of dust_color_transition particles.
+type DustColorTransitionOptions struct {
+	// FromColor and ToColor are RGB colors.
+	FromColor int32
+	ToColor   int32
+	Scale     float32
+}
+
+func (o *DustColorTransitionOptions) Read(buf *ns.PacketBuffer) error {
+	from, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	to, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	scale, err := buf.ReadFloat32()
+	o.FromColor, o.ToColor, o.Scale = int32(from), int32(to), float32(scale)
+	return err
+}
+
+func (o *DustColorTransitionOptions) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt32(ns.Int32(o.FromColor)); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(ns.Int32(o.ToColor)); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(ns.Float32(o.Scale))
+}
+
+// ColorParticleOptions are the options of entity_effect, tinted_leaves and flash particles.
+type ColorParticleOptions struct {
+	// Color is the ARGB color, e.g. 0xff00ff00 for opaque green.
+	Color int32
+}
+
+func (o *ColorParticleOptions) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadInt32()
+	o.Color = int32(v)
+	return err
+}
+
+func (o *ColorParticleOptions) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteInt32(ns.Int32(o.Color))
+}
+
+// SpellParticleOptions are the options of effect and instant_effect particles.
+type SpellParticleOptions struct {
+	// Color is the RGB color.
+	Color int32
+	Power float32
+}
+
+func (o *SpellParticleOptions) Read(buf *ns.PacketBuffer) error {
+	color, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	power, err := buf.ReadFloat32()
+	o.Color, o.Power = int32(color), float32(power)
+	return err
+}
+
+func (o *SpellParticleOptions) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt32(ns.Int32(o.Color)); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(ns.Float32(o.Power))
+}
+
+// PowerParticleOptions are the options of dragon_breath particles.
+type PowerParticleOptions struct {
+	Power float32
+}
+
+func (o *PowerParticleOptions) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadFloat32()
+	o.Power = float32(v)
+	return err
+}
+
+func (o *PowerParticleOptions) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteFloat32(ns.Float32(o.Power))
+}
+
+// ItemParticleOptions are the options of item particles.
+type ItemParticleOptions struct {
+	Item *items.ItemStack
+}
+
+func (o *ItemParticleOptions) Read(buf *ns.PacketBuffer) error {
+	var err error
+	o.Item, err = items.ReadSlot(buf)
+	return err
+}
+
+func (o *ItemParticleOptions) Write(buf *ns.PacketBuffer) error {
+	if o.Item == nil {
+		return items.EmptyStack().WriteSlot(buf)
+	}
+	return o.Item.WriteSlot(buf)
+}
+
+// PositionSource is the target of a vibration: a block or an entity.
+type PositionSource struct {
+	// Type is "minecraft:block" or "minecraft:entity".
+	Type string
+	// Block is the target block, for block sources.
+	Block Position
+	// EntityID and YOffset are the target entity and its eye offset, for entity sources.
+	EntityID int32
+	YOffset  float32
+}
+
+func (s *PositionSource) Read(buf *ns.PacketBuffer) error {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	s.Type = registries.PositionSourceType.ByID(int32(id))
+	switch s.Type {
+	case "minecraft:block":
+		pos, err := buf.ReadPosition()
+		s.Block = Position{X: int32(pos.X), Y: int32(pos.Y), Z: int32(pos.Z)}
+		return err
+	case "minecraft:entity":
+		entityID, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		offset, err := buf.ReadFloat32()
+		s.EntityID, s.YOffset = int32(entityID), float32(offset)
+		return err
+	}
+	return fmt.Errorf("unknown position source type %d", id)
+}
+
+func (s *PositionSource) Write(buf *ns.PacketBuffer) error {
+	id := registries.PositionSourceType.Get(s.Type)
+	if id < 0 {
+		return fmt.Errorf("unknown position source type %q", s.Type)
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	if s.Type == "minecraft:block" {
+		return buf.WritePosition(ns.Position{X: int(s.Block.X), Y: int(s.Block.Y), Z: int(s.Block.Z)})
+	}
+	if err := buf.WriteVarInt(ns.VarInt(s.EntityID)); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(ns.Float32(s.YOffset))
+}
+
+// VibrationParticleOptions are the options of vibration particles.
+type VibrationParticleOptions struct {
+	Destination PositionSource
+	// ArrivalInTicks is the travel time of the vibration.
+	ArrivalInTicks int32
+}
+
+func (o *VibrationParticleOptions) Read(buf *ns.PacketBuffer) error {
+	if err := o.Destination.Read(buf); err != nil {
+		return err
+	}
+	v, err := buf.ReadVarInt()
+	o.ArrivalInTicks = int32(v)
+	return err
+}
+
+func (o *VibrationParticleOptions) Write(buf *ns.PacketBuffer) error {
+	if err := o.Destination.Write(buf); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(ns.VarInt(o.ArrivalInTicks))
+}
+
+// TrailParticleOptions are the options of trail particles.
+type TrailParticleOptions struct {
+	TargetX, TargetY, TargetZ float64
+	// Color is the RGB color.
+	Color    int32
+	Duration int32
+}
+
+func (o *TrailParticleOptions) Read(buf *ns.PacketBuffer) error {
+	x, err := buf.ReadFloat64()
+	if err != nil {
+		return err
+	}
+	y, err := buf.ReadFloat64()
+	if err != nil {
+		return err
+	}
+	z, err := buf.ReadFloat64()
+	if err != nil {
+		return err
+	}
+	color, err := buf.ReadInt32()
+	if err != nil {
+		return err
+	}
+	duration, err := buf.ReadVarInt()
+	o.TargetX, o.TargetY, o.TargetZ = float64(x), float64(y), float64(z)
+	o.Color, o.Duration = int32(color), int32(duration)
+	return err
+}
+
+func (o *TrailParticleOptions) Write(buf *ns.PacketBuffer) error {
+	for _, v := range []float64{o.TargetX, o.TargetY, o.TargetZ} {
+		if err := buf.WriteFloat64(ns.Float64(v)); err != nil {
+			return err
+		}
+	}
+	if err := buf.WriteInt32(ns.Int32(o.Color)); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(ns.VarInt(o.Duration))
+}
+
+// ShriekParticleOptions are the options of shriek particles.
+type ShriekParticleOptions struct {
+	// Delay is the number of ticks before the particle appears.
+	Delay int32
+}
+
+func (o *ShriekParticleOptions) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadVarInt()
+	o.Delay = int32(v)
+	return err
+}
+
+func (o *ShriekParticleOptions) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(ns.VarInt(o.Delay))
+}
+
+// SculkChargeParticleOptions are the options of sculk_charge particles.
+type SculkChargeParticleOptions struct {
+	// Roll is the rotation in radians.
+	Roll float32
+}
+
+func (o *SculkChargeParticleOptions) Read(buf *ns.PacketBuffer) error {
+	v, err := buf.ReadFloat32()
+	o.Roll = float32(v)
+	return err
+}
+
+func (o *SculkChargeParticleOptions) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteFloat32(ns.Float32(o.Roll))
+}
@@ -0,0 +1,143 @@
+// Package explosions predicts the damage and knockback of explosions (S2CExplode),
+// following the vanilla server explosion logic.
+package explosions
+
+import (
+	"math"
+
+	"github.com/go-mclib/data/pkg/data/hitboxes"
+	entityhitboxes "github.com/go-mclib/data/pkg/data/hitboxes/entities"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Explosion is the center and radius of an explosion, e.g. 4 for TNT.
+type Explosion struct {
+	X, Y, Z float64
+	Radius  float32
+}
+
+// FromPacket returns the explosion described by an S2CExplode packet.
+func FromPacket(p *packets.S2CExplode) Explosion {
+	return Explosion{X: float64(p.X), Y: float64(p.Y), Z: float64(p.Z), Radius: float32(p.Radius)}
+}
+
+// Diameter returns the range of the explosion: entities whose feet are farther
+// from the center are not affected.
+func (e Explosion) Diameter() float64 {
+	return float64(e.Radius * 2)
+}
+
+// Entity is an entity exposed to an explosion.
+type Entity struct {
+	// Type is the entity type, e.g. "minecraft:player".
+	Type string
+	// X, Y, Z is the feet position.
+	X, Y, Z float64
+	// KnockbackResistance is the explosion_knockback_resistance attribute value,
+	// or 0 for entities that are not living.
+	KnockbackResistance float64
+}
+
+// Hitbox returns the standing hitbox of the entity.
+func (en Entity) Hitbox() hitboxes.AABB {
+	return Hitbox(en.Type, en.X, en.Y, en.Z)
+}
+
+// Hitbox returns the standing hitbox of an entity type at the given feet position,
+// or an empty box at the position if the type is unknown.
+func Hitbox(entityType string, x, y, z float64) hitboxes.AABB {
+	width, height, _ := entityhitboxes.Dimensions(entityType)
+	w := float64(width) / 2
+	return hitboxes.AABB{
+		MinX: x - w, MinY: y, MinZ: z - w,
+		MaxX: x + w, MaxY: y + float64(height), MaxZ: z + w,
+	}
+}
+
+// Impact is the effect of an explosion on an entity.
+type Impact struct {
+	// Distance is the distance from the center relative to the diameter
+	// (0 at the center, 1 at the edge).
+	Distance float64
+	// Exposure is the fraction of the hitbox visible from the center (0-1).
+	Exposure float64
+	// Damage is the damage before difficulty scaling, armor and enchantments.
+	Damage float32
+	// Knockback is the velocity added to the entity.
+	KnockbackX, KnockbackY, KnockbackZ float64
+}
+
+// Impact returns the effect of the explosion on an entity whose hitbox is the given
+// fraction exposed (see Exposure; use 1 when no blocks are in the way).
+// It returns false if the entity is out of range. An entity whose eyes (feet for primed
+// TNT) are at the center is damaged but not pushed.
+func (e Explosion) Impact(en Entity, exposure float64) (Impact, bool) {
+	diameter := e.Diameter()
+	if diameter <= 0 {
+		return Impact{}, false
+	}
+	dx, dy, dz := en.X-e.X, en.Y-e.Y, en.Z-e.Z
+	distance := math.Sqrt(dx*dx+dy*dy+dz*dz) / diameter
+	if distance > 1 {
+		return Impact{}, false
+	}
+
+	// primed TNT is pushed from its feet, everything else from the eyes
+	if en.Type != "minecraft:tnt" {
+		dy += float64(entityhitboxes.EyeHeight(en.Type))
+	}
+	// like Vec3.normalize, there is no direction to push in at the center
+	length := math.Sqrt(dx*dx + dy*dy + dz*dz)
+	if length < 1e-5 {
+		dx, dy, dz = 0, 0, 0
+	} else {
+		dx, dy, dz = dx/length, dy/length, dz/length
+	}
+
+	impact := (1 - distance) * exposure
+	knockback := impact * (1 - en.KnockbackResistance)
+	return Impact{
+		Distance:   distance,
+		Exposure:   exposure,
+		Damage:     float32((impact*impact+impact)/2*7*diameter + 1),
+		KnockbackX: dx * knockback,
+		KnockbackY: dy * knockback,
+		KnockbackZ: dz * knockback,
+	}, true
+}
+
+// RayClear reports whether the ray between two points does not hit any block collision shape.
+type RayClear func(fromX, fromY, fromZ, toX, toY, toZ float64) bool
+
+// Exposure returns the fraction of a hitbox that is visible from the explosion center,
+// sampling the box on the same grid as the vanilla server.
+func (e Explosion) Exposure(box hitboxes.AABB, clear RayClear) float64 {
+	stepX := 1 / ((box.MaxX-box.MinX)*2 + 1)
+	stepY := 1 / ((box.MaxY-box.MinY)*2 + 1)
+	stepZ := 1 / ((box.MaxZ-box.MinZ)*2 + 1)
+	if stepX < 0 || stepY < 0 || stepZ < 0 {
+		return 0
+	}
+	offsetX := (1 - math.Floor(1/stepX)*stepX) / 2
+	offsetZ := (1 - math.Floor(1/stepZ)*stepZ) / 2
+
+	visible, total := 0, 0
+	for x := 0.0; x <= 1; x += stepX {
+		for y := 0.0; y <= 1; y += stepY {
+			for z := 0.0; z <= 1; z += stepZ {
+				px := lerp(x, box.MinX, box.MaxX) + offsetX
+				py := lerp(y, box.MinY, box.MaxY)
+				pz := lerp(z, box.MinZ, box.MaxZ) + offsetZ
+				if clear(px, py, pz, e.X, e.Y, e.Z) {
+					visible++
+				}
+				total++
+			}
+		}
+	}
+	return float64(float32(visible) / float32(total))
+}
+
+func lerp(delta, start, end float64) float64 {
+	return start + delta*(end-start)
+}
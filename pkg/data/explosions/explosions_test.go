@@ -0,0 +1,82 @@
+package explosions_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/entities"
+	"github.com/go-mclib/data/pkg/data/explosions"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromPacket(t *testing.T) {
+	p := &packets.S2CExplode{
+		X: 0.5, Y: 64, Z: 0.5,
+		Radius:            4,
+		BlockCount:        27,
+		PlayerKnockback:   ns.Some(packets.Vec3{X: 0.5, Y: 0.4, Z: 0}),
+		ExplosionParticle: entities.Particle{Type: "minecraft:explosion_emitter"},
+	}
+	e := explosions.FromPacket(p)
+	assert.Equal(t, explosions.Explosion{X: 0.5, Y: 64, Z: 0.5, Radius: 4}, e)
+	assert.Equal(t, 8.0, e.Diameter())
+}
+
+func TestImpact(t *testing.T) {
+	tnt := explosions.Explosion{X: 0, Y: 64, Z: 0, Radius: 4}
+	player := explosions.Entity{Type: "minecraft:player", X: 2, Y: 64, Z: 0}
+
+	impact, ok := tnt.Impact(player, 1)
+	require.True(t, ok)
+	assert.InDelta(t, 0.25, impact.Distance, 1e-9)
+	assert.InDelta(t, 37.75, impact.Damage, 1e-4)
+	// pushed away from the center, through the eyes (1.62 above the feet)
+	assert.InDelta(t, 0.75*2/2.573791, impact.KnockbackX, 1e-5)
+	assert.InDelta(t, 0.75*1.62/2.573791, impact.KnockbackY, 1e-5)
+	assert.Zero(t, impact.KnockbackZ)
+
+	// knockback resistance only reduces the push
+	player.KnockbackResistance = 1
+	resisted, ok := tnt.Impact(player, 1)
+	require.True(t, ok)
+	assert.Equal(t, impact.Damage, resisted.Damage)
+	assert.Zero(t, resisted.KnockbackX)
+
+	// fully covered entities only take the base damage
+	covered, ok := tnt.Impact(player, 0)
+	require.True(t, ok)
+	assert.Equal(t, float32(1), covered.Damage)
+
+	_, ok = tnt.Impact(explosions.Entity{Type: "minecraft:player", X: 8.5, Y: 64}, 1)
+	assert.False(t, ok)
+
+	// entities at the exact center take full damage but are not pushed
+	centered, ok := tnt.Impact(explosions.Entity{Type: "minecraft:tnt", X: 0, Y: 64, Z: 0}, 1)
+	require.True(t, ok)
+	assert.Equal(t, float32(57), centered.Damage)
+	assert.Zero(t, centered.KnockbackX)
+	assert.Zero(t, centered.KnockbackY)
+	assert.Zero(t, centered.KnockbackZ)
+	centered, ok = tnt.Impact(explosions.Entity{Type: "minecraft:player", X: 0, Y: 64 - 1.62, Z: 0}, 1)
+	require.True(t, ok)
+	assert.Greater(t, centered.Damage, float32(1))
+	assert.Zero(t, centered.KnockbackY)
+}
+
+func TestExposure(t *testing.T) {
+	tnt := explosions.Explosion{X: 0, Y: 64, Z: 0, Radius: 4}
+	box := explosions.Hitbox("minecraft:player", 2, 64, 0)
+	assert.InDelta(t, 0.6, box.MaxX-box.MinX, 1e-6)
+	assert.InDelta(t, 1.8, box.MaxY-box.MinY, 1e-6)
+
+	clear := func(_, _, _, _, _, _ float64) bool { return true }
+	assert.Equal(t, 1.0, tnt.Exposure(box, clear))
+
+	// a wall covering everything below y=65 hides the lower part of the hitbox
+	wall := func(_, fromY, _, _, _, _ float64) bool { return fromY >= 65 }
+	exposure := tnt.Exposure(box, wall)
+	assert.Greater(t, exposure, 0.0)
+	assert.Less(t, exposure, 1.0)
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// rangedAttribute is an attribute parsed from Attributes.java.
+type rangedAttribute struct {
+	Default, Min, Max float64
+	Syncable          bool
+}
+
+// generateAttributes emits the default value, bounds and syncability of every
+// entity attribute, parsed from Attributes.java and indexed by protocol ID.
+func generateAttributes(registries map[string]RegistryJSON, decompiledDir, outPath string) {
+	registry, ok := registries["minecraft:attribute"]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "warning: no minecraft:attribute registry for attributes\n")
+		return
+	}
+
+	attributesJava := filepath.Join(decompiledDir, "net", "minecraft", "world", "entity", "ai", "attributes", "Attributes.java")
+	data, err := os.ReadFile(attributesJava)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: cannot read Attributes.java: %v\n", err)
+		return
+	}
+
+	names := make([]string, len(registry.Entries))
+	for name, entry := range registry.Entries {
+		names[entry.ProtocolID] = name
+	}
+	writeAttributes(names, parseAttributes(string(data)), outPath)
+}
+
+// parseAttributes extracts the ranged attributes, e.g.
+// register("armor", new RangedAttribute("attribute.name.armor", 0.0, 0.0, 30.0).setSyncable(true)).
+func parseAttributes(src string) map[string]rangedAttribute {
+	num := `\s*(-?[0-9.]+(?:E-?[0-9]+)?)[DF]?\s*`
+	attrRe := regexp.MustCompile(`register\(\s*"([a-z0-9_.]+)"\s*,\s*new RangedAttribute\(\s*"[^"]*"\s*,` + num + `,` + num + `,` + num + `\)([^;]*);`)
+	attrs := make(map[string]rangedAttribute)
+	for _, m := range attrRe.FindAllStringSubmatch(src, -1) {
+		var vals [3]float64
+		for i := range vals {
+			vals[i], _ = strconv.ParseFloat(m[i+2], 64)
+		}
+		attrs["minecraft:"+m[1]] = rangedAttribute{
+			Default:  vals[0],
+			Min:      vals[1],
+			Max:      vals[2],
+			Syncable: strings.Contains(m[5], "setSyncable(true)"),
+		}
+	}
+	return attrs
+}
+
+// writeAttributes writes the attribute table, indexed by protocol ID.
+func writeAttributes(names []string, attrs map[string]rangedAttribute, outPath string) {
+	var sb strings.Builder
+	sb.WriteString(generatedFileHeader("attributes"))
+	sb.WriteString("// attributes lists the entity attributes by protocol ID.\n")
+	sb.WriteString(fmt.Sprintf("var attributes = [%d]Attribute{\n", len(names)))
+	missing := 0
+	for id, name := range names {
+		a, ok := attrs[name]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "warning: attribute %s not found in Attributes.java\n", name)
+			missing++
+		}
+		sb.WriteString(fmt.Sprintf("\t{ID: %d, Name: %q, Default: %s, Min: %s, Max: %s, Syncable: %t},\n",
+			id, name, formatAttributeValue(a.Default), formatAttributeValue(a.Min), formatAttributeValue(a.Max), a.Syncable))
+	}
+	sb.WriteString("}\n")
+
+	writeFile(outPath, sb.String())
+	fmt.Printf("attributes: %d attributes, %d missing\n", len(names), missing)
+}
+
+func formatAttributeValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// CommandNodeJSON is a node of the commands.json report.
+type CommandNodeJSON struct {
+	Type       string                      `json:"type"`
+	Children   map[string]*CommandNodeJSON `json:"children,omitempty"`
+	Executable bool                        `json:"executable,omitempty"`
+	Redirect   []string                    `json:"redirect,omitempty"`
+	Parser     string                      `json:"parser,omitempty"`
+	Properties map[string]json.RawMessage  `json:"properties,omitempty"`
+}
+
+// flatCommandNode is a report node with its position in the flattened graph.
+type flatCommandNode struct {
+	name     string
+	node     *CommandNodeJSON
+	children []int
+}
+
+// generateCommands flattens the vanilla command tree from commands.json into
+// the same node array layout used by S2CCommands (breadth-first, root at 0).
+func generateCommands(commandsPath, outPath string) {
+	data, err := os.ReadFile(commandsPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: cannot read commands.json: %v\n", err)
+		return
+	}
+	var root CommandNodeJSON
+	if err := json.Unmarshal(data, &root); err != nil {
+		panic(fmt.Sprintf("failed to parse %s: %v", commandsPath, err))
+	}
+
+	nodes := []*flatCommandNode{{node: &root}}
+	for i := 0; i < len(nodes); i++ {
+		n := nodes[i]
+		for _, name := range sortedKeys(n.node.Children) {
+			n.children = append(n.children, len(nodes))
+			nodes = append(nodes, &flatCommandNode{name: name, node: n.node.Children[name]})
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(generatedFileHeader("commands"))
+	sb.WriteString("// vanillaGraph is the vanilla command tree, flattened from the commands.json report.\n")
+	sb.WriteString("var vanillaGraph = Graph{\n\tRoot: 0,\n\tNodes: []Node{\n")
+	for i, n := range nodes {
+		var fields []string
+		switch n.node.Type {
+		case "root":
+			fields = append(fields, "Type: NodeRoot")
+		case "literal":
+			fields = append(fields, "Type: NodeLiteral", fmt.Sprintf("Name: %q", n.name))
+		case "argument":
+			fields = append(fields, "Type: NodeArgument", fmt.Sprintf("Name: %q", n.name))
+		default:
+			panic(fmt.Sprintf("commands: node %d: unknown type %q", i, n.node.Type))
+		}
+		if n.node.Executable {
+			fields = append(fields, "Executable: true")
+		}
+		if len(n.children) > 0 {
+			ids := make([]string, len(n.children))
+			for j, c := range n.children {
+				ids[j] = strconv.Itoa(c)
+			}
+			fields = append(fields, "Children: []int32{"+strings.Join(ids, ", ")+"}")
+		}
+		fields = append(fields, fmt.Sprintf("Redirect: %d", resolveCommandRedirect(nodes, n.node.Redirect)))
+		if n.node.Type == "argument" {
+			fields = append(fields, "Parser: "+commandParserLiteral(n.node))
+		}
+		sb.WriteString("\t\t{" + strings.Join(fields, ", ") + "},\n")
+	}
+	sb.WriteString("\t},\n}\n")
+
+	writeFile(outPath, sb.String())
+	fmt.Printf("commands: flattened %d nodes\n", len(nodes))
+}
+
+// resolveCommandRedirect resolves a redirect path (e.g. ["execute"]) to a node index.
+// A missing path means no redirect, an empty path redirects to the root.
+func resolveCommandRedirect(nodes []*flatCommandNode, path []string) int {
+	if path == nil {
+		return -1
+	}
+	index := 0
+	for _, name := range path {
+		found := -1
+		for _, c := range nodes[index].children {
+			if nodes[c].name == name {
+				found = c
+				break
+			}
+		}
+		if found < 0 {
+			panic(fmt.Sprintf("commands: unresolved redirect %v", path))
+		}
+		index = found
+	}
+	return index
+}
+
+// commandParserLiteral returns the Go literal of an argument node's parser.
+func commandParserLiteral(n *CommandNodeJSON) string {
+	props := func(name string) (string, bool) {
+		raw, ok := n.Properties[name]
+		if !ok {
+			return "", false
+		}
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			return s, true
+		}
+		return string(raw), true
+	}
+	bounds := func(goType string) string {
+		var fields []string
+		if v, ok := props("min"); ok {
+			fields = append(fields, fmt.Sprintf("Min: ptr[%s](%s)", goType, v))
+		}
+		if v, ok := props("max"); ok {
+			fields = append(fields, fmt.Sprintf("Max: ptr[%s](%s)", goType, v))
+		}
+		return strings.Join(fields, ", ")
+	}
+
+	var properties string
+	switch n.Parser {
+	case "brigadier:float":
+		properties = "&FloatProperties{" + bounds("float32") + "}"
+	case "brigadier:double":
+		properties = "&DoubleProperties{" + bounds("float64") + "}"
+	case "brigadier:integer":
+		properties = "&IntegerProperties{" + bounds("int32") + "}"
+	case "brigadier:long":
+		properties = "&LongProperties{" + bounds("int64") + "}"
+	case "brigadier:string":
+		behavior := "StringSingleWord"
+		switch v, _ := props("type"); v {
+		case "phrase":
+			behavior = "StringQuotablePhrase"
+		case "greedy":
+			behavior = "StringGreedyPhrase"
+		}
+		properties = "&StringProperties{Behavior: " + behavior + "}"
+	case "minecraft:entity":
+		amount, _ := props("amount")
+		kind, _ := props("type")
+		properties = fmt.Sprintf("&EntityProperties{Single: %t, PlayersOnly: %t}", amount == "single", kind == "players")
+	case "minecraft:score_holder":
+		amount, _ := props("amount")
+		properties = fmt.Sprintf("&ScoreHolderProperties{AllowMultiple: %t}", amount == "multiple")
+	case "minecraft:time":
+		minimum, ok := props("min")
+		if !ok {
+			minimum = "0"
+		}
+		properties = "&TimeProperties{Min: " + minimum + "}"
+	case "minecraft:resource_or_tag", "minecraft:resource_or_tag_key",
+		"minecraft:resource", "minecraft:resource_key", "minecraft:resource_selector":
+		registry, _ := props("registry")
+		properties = fmt.Sprintf("&RegistryProperties{Registry: %q}", registry)
+	}
+
+	if properties == "" {
+		return fmt.Sprintf("&Parser{ID: %q}", n.Parser)
+	}
+	return fmt.Sprintf("&Parser{ID: %q, Properties: %s}", n.Parser, properties)
+}
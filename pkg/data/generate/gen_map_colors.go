@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type mapBaseColor struct {
+	id   int
+	name string
+	rgb  int
+}
+
+type mapBrightness struct {
+	id       int
+	name     string
+	modifier int
+}
+
+// generateMapColors parses MapColor.java and emits the packed map color table
+// (base color ID * 4 + brightness) with every brightness shade precomputed.
+func generateMapColors(decompiledDir, outPath string) {
+	mapColorJava := filepath.Join(decompiledDir, "net", "minecraft", "world", "level", "material", "MapColor.java")
+	data, err := os.ReadFile(mapColorJava)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: cannot read MapColor.java for map colors: %v\n", err)
+		return
+	}
+
+	colors, brightness := parseMapColors(string(data))
+	if len(colors) == 0 || len(brightness) == 0 {
+		fmt.Fprintf(os.Stderr, "warning: no map colors found in MapColor.java\n")
+		return
+	}
+
+	numColors := colors[len(colors)-1].id + 1
+	byID := make([]*mapBaseColor, numColors)
+	for i := range colors {
+		byID[colors[i].id] = &colors[i]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(generatedFileHeader("mapdata"))
+	sb.WriteString("import \"image/color\"\n\n")
+	sb.WriteString("// brightness shades, as the low two bits of a packed map color\n")
+	sb.WriteString("const (\n")
+	for _, b := range brightness {
+		sb.WriteString(fmt.Sprintf("\tBrightness%s = %d // x%d/255\n", toGoName(strings.ToLower(b.name)), b.id, b.modifier))
+	}
+	sb.WriteString(")\n\n")
+
+	sb.WriteString("// baseColorNames maps base color IDs to their MapColor field names.\n")
+	sb.WriteString(fmt.Sprintf("var baseColorNames = [%d]string{\n", numColors))
+	for _, c := range byID {
+		if c != nil {
+			sb.WriteString(fmt.Sprintf("\t%d: %q,\n", c.id, strings.ToLower(c.name)))
+		}
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// mapColors maps packed map colors to RGBA. The shades of base color 0 are transparent.\n")
+	sb.WriteString(fmt.Sprintf("var mapColors = [%d]color.RGBA{\n", numColors*4))
+	for _, c := range byID {
+		if c == nil || c.id == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\t// %s\n", strings.ToLower(c.name)))
+		for _, b := range brightness {
+			r := (c.rgb >> 16 & 0xFF) * b.modifier / 255
+			g := (c.rgb >> 8 & 0xFF) * b.modifier / 255
+			bl := (c.rgb & 0xFF) * b.modifier / 255
+			sb.WriteString(fmt.Sprintf("\t%d: {%d, %d, %d, 255},\n", c.id*4+b.id, r, g, bl))
+		}
+	}
+	sb.WriteString("}\n")
+
+	writeFile(outPath, sb.String())
+	fmt.Printf("map colors: extracted %d base colors\n", len(colors))
+}
+
+// parseMapColors extracts the base colors and brightness modifiers from MapColor.java source.
+func parseMapColors(src string) ([]mapBaseColor, []mapBrightness) {
+	colorRe := regexp.MustCompile(`public static final MapColor ([A-Z_]+) = new MapColor\((\d+),\s*(-?(?:0x)?[0-9A-Fa-f]+)\)`)
+	brightnessRe := regexp.MustCompile(`\b([A-Z]+)\((\d+),\s*(\d+)\)[,;]`)
+
+	var colors []mapBaseColor
+	for _, m := range colorRe.FindAllStringSubmatch(src, -1) {
+		id, _ := strconv.Atoi(m[2])
+		rgb, err := strconv.ParseInt(m[3], 0, 64)
+		if err != nil {
+			continue
+		}
+		colors = append(colors, mapBaseColor{id: id, name: m[1], rgb: int(rgb)})
+	}
+	sort.Slice(colors, func(i, j int) bool { return colors[i].id < colors[j].id })
+
+	var brightness []mapBrightness
+	if start := strings.Index(src, "enum Brightness"); start >= 0 {
+		for _, m := range brightnessRe.FindAllStringSubmatch(src[start:], -1) {
+			id, _ := strconv.Atoi(m[2])
+			modifier, _ := strconv.Atoi(m[3])
+			brightness = append(brightness, mapBrightness{id: id, name: m[1], modifier: modifier})
+		}
+	}
+	sort.Slice(brightness, func(i, j int) bool { return brightness[i].id < brightness[j].id })
+	return colors, brightness
+}
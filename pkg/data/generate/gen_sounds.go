@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// generateSounds emits the metadata of every sound event: the sound source it is
+// usually played in, derived from its name, and its fixed range from SoundEvents.java.
+func generateSounds(registries map[string]RegistryJSON, decompiledDir, entityTypeJavaPath, outPath string) {
+	registry, ok := registries["minecraft:sound_event"]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "warning: no minecraft:sound_event registry for sounds\n")
+		return
+	}
+
+	ranges := map[string]float64{}
+	soundEventsJava := filepath.Join(decompiledDir, "net", "minecraft", "sounds", "SoundEvents.java")
+	if data, err := os.ReadFile(soundEventsJava); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: cannot read SoundEvents.java, all sounds use the variable range: %v\n", err)
+	} else {
+		ranges = parseSoundRanges(string(data))
+	}
+
+	names := make([]string, len(registry.Entries))
+	for name, entry := range registry.Entries {
+		names[entry.ProtocolID] = name
+	}
+	writeSounds(names, ranges, extractMobCategories(entityTypeJavaPath), outPath)
+}
+
+// parseSoundRanges extracts the sound events registered with a fixed range,
+// e.g. register("item.goat_horn.sound.0", 256.0F).
+func parseSoundRanges(src string) map[string]float64 {
+	fixedRe := regexp.MustCompile(`register(?:ForHolder)?\(\s*"([a-z0-9_./-]+)"\s*,\s*([0-9.]+)F\s*\)`)
+	ranges := make(map[string]float64)
+	for _, m := range fixedRe.FindAllStringSubmatch(src, -1) {
+		if r, err := strconv.ParseFloat(m[2], 64); err == nil {
+			ranges["minecraft:"+m[1]] = r
+		}
+	}
+	return ranges
+}
+
+// writeSounds writes the sound event table, indexed by protocol ID.
+func writeSounds(names []string, ranges map[string]float64, mobCategories map[string]string, outPath string) {
+	var sb strings.Builder
+	sb.WriteString(generatedFileHeader("sounds"))
+	sb.WriteString("import \"github.com/go-mclib/data/pkg/packets\"\n\n")
+	sb.WriteString("// soundEvents lists the sound events by protocol ID.\n")
+	sb.WriteString(fmt.Sprintf("var soundEvents = [%d]Sound{\n", len(names)))
+	for id, name := range names {
+		fields := []string{
+			fmt.Sprintf("ID: %d", id),
+			fmt.Sprintf("Name: %q", name),
+			"Source: packets.SoundSource" + soundSource(name, mobCategories),
+		}
+		if r, ok := ranges[name]; ok {
+			fields = append(fields, "FixedRange: "+strconv.FormatFloat(r, 'g', -1, 32))
+		}
+		sb.WriteString("\t{" + strings.Join(fields, ", ") + "},\n")
+	}
+	sb.WriteString("}\n")
+
+	writeFile(outPath, sb.String())
+	fmt.Printf("sounds: %d sound events, %d with a fixed range\n", len(names), len(ranges))
+}
+
+// soundSource returns the SoundSource constant suffix a sound event is usually played in,
+// based on the first segments of its name (e.g. "entity.zombie.ambient" is hostile).
+func soundSource(name string, mobCategories map[string]string) string {
+	path := strings.Split(strings.TrimPrefix(name, "minecraft:"), ".")
+	switch path[0] {
+	case "music":
+		return "Music"
+	case "music_disc":
+		return "Records"
+	case "weather":
+		return "Weather"
+	case "block":
+		return "Blocks"
+	case "ambient", "particle":
+		return "Ambient"
+	case "ui":
+		return "UI"
+	case "item", "enchant":
+		return "Players"
+	case "entity":
+		if len(path) < 2 {
+			break
+		}
+		if path[1] == "player" {
+			return "Players"
+		}
+		if mobCategories["minecraft:"+path[1]] == "monster" {
+			return "Hostile"
+		}
+		return "Neutral"
+	}
+	return "Master"
+}
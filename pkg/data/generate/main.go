@@ -18,6 +18,7 @@ func main() {
 	items := loadItems(baseDir)
 	packets := loadJSON[PacketsJSON](filepath.Join(baseDir, "packets.json"))
 	langPath := filepath.Join(baseDir, "en_us.json")
+	commandsPath := filepath.Join(baseDir, "commands.json")
 
 	outDir := filepath.Dir(baseDir)
 	decompiledDir := filepath.Join(outDir, "..", "..", "decompiled", "current")
@@ -45,6 +46,10 @@ func main() {
 	generateRegistryData(datapackDir, filepath.Join(outDir, "registries", "registry_data_gen.go"))
 	generateTagData(filepath.Join(decompiledDir, "data", "minecraft", "tags"), filepath.Join(outDir, "registries", "tag_data_gen.go"))
 	generateBlockHardness(decompiledDir, filepath.Join(outDir, "blocks", "block_hardness_gen.go"))
+	generateCommands(commandsPath, filepath.Join(outDir, "commands", "commands_gen.go"))
+	generateMapColors(decompiledDir, filepath.Join(outDir, "mapdata", "map_colors_gen.go"))
+	generateSounds(registries, decompiledDir, decompiledEntityType, filepath.Join(outDir, "sounds", "sounds_gen.go"))
+	generateAttributes(registries, decompiledDir, filepath.Join(outDir, "attributes", "attributes_gen.go"))
 
 	fmt.Println("generation complete")
 }
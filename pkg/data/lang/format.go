@@ -0,0 +1,87 @@
+package lang
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// formatPattern matches the arguments of translation format strings: %s, %1$s
+// and %%.
+var formatPattern = regexp.MustCompile(`%(?:(\d+)\$)?([A-Za-z%]|$)`)
+
+// FormatPart is a literal of a translation format string, or the index of an
+// argument if Arg >= 0.
+type FormatPart struct {
+	Literal string
+	Arg     int
+}
+
+// ParseFormat splits a translation format string such as "%s whispers to you:
+// %s" for the given number of arguments. It reports false for formats the
+// vanilla client rejects: conversions other than %s, stray % signs and
+// arguments out of range. The client shows those formats as they are.
+func ParseFormat(format string, args int) ([]FormatPart, bool) {
+	var parts []FormatPart
+	literal := func(s string) bool {
+		if s == "" {
+			return true
+		}
+		if strings.Contains(s, "%") {
+			return false
+		}
+		parts = append(parts, FormatPart{Literal: s, Arg: -1})
+		return true
+	}
+	next, end := 0, 0
+	for _, m := range formatPattern.FindAllStringSubmatchIndex(format, -1) {
+		if !literal(format[end:m[0]]) {
+			return nil, false
+		}
+		end = m[1]
+		conversion := format[m[4]:m[5]]
+		switch {
+		case conversion == "%" && m[1]-m[0] == 2:
+			parts = append(parts, FormatPart{Literal: "%", Arg: -1})
+			continue
+		case conversion != "s":
+			return nil, false
+		}
+		i := next
+		if m[2] >= 0 {
+			n, err := strconv.Atoi(format[m[2]:m[3]])
+			if err != nil {
+				return nil, false
+			}
+			i = n - 1
+		} else {
+			next++
+		}
+		if i < 0 || i >= args {
+			return nil, false
+		}
+		parts = append(parts, FormatPart{Arg: i})
+	}
+	if !literal(format[end:]) {
+		return nil, false
+	}
+	return parts, true
+}
+
+// Sprintf fills in the arguments of a translation format string. Invalid
+// formats are returned as they are.
+func Sprintf(format string, args ...string) string {
+	parts, ok := ParseFormat(format, len(args))
+	if !ok {
+		return format
+	}
+	var b strings.Builder
+	for _, p := range parts {
+		if p.Arg < 0 {
+			b.WriteString(p.Literal)
+		} else {
+			b.WriteString(args[p.Arg])
+		}
+	}
+	return b.String()
+}
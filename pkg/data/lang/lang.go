@@ -1,3 +1,5 @@
+// Package lang translates Minecraft translation keys. English is embedded;
+// other locales are loaded from language files.
 package lang
 
 // Translate returns the English translation for a translation key, or empty string if not found.
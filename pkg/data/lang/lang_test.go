@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/go-mclib/data/pkg/data/lang"
+	"github.com/go-mclib/data/pkg/data/text"
 	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
 )
 
@@ -84,9 +85,9 @@ func TestTextComponentString(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.tc.Render(lang.Translate)
+			got := text.Plain(tt.tc)
 			if got != tt.want {
-				t.Errorf("Render() = %q, want %q", got, tt.want)
+				t.Errorf("Plain() = %q, want %q", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,205 @@
+package lang
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Locale is a language's translations, falling back to another locale for
+// missing keys.
+type Locale struct {
+	// Code is the lowercase locale code, e.g. "de_de".
+	Code         string
+	translations map[string]string
+	fallback     *Locale
+}
+
+// English is the embedded en_us locale, the end of every fallback chain.
+var English = &Locale{Code: "en_us", translations: translations}
+
+// NewLocale returns a locale with the given translations. fallback may be nil.
+func NewLocale(code string, translations map[string]string, fallback *Locale) *Locale {
+	return &Locale{Code: strings.ToLower(code), translations: translations, fallback: fallback}
+}
+
+// Fallback returns the locale used for keys this locale lacks, or nil.
+func (l *Locale) Fallback() *Locale {
+	return l.fallback
+}
+
+// Translate returns the translation of a key from the first locale of the
+// fallback chain that has it, or empty string if none does.
+func (l *Locale) Translate(key string) string {
+	for ; l != nil; l = l.fallback {
+		if s, ok := l.translations[key]; ok {
+			return s
+		}
+	}
+	return ""
+}
+
+// Has reports whether a locale of the fallback chain translates the key.
+func (l *Locale) Has(key string) bool {
+	for ; l != nil; l = l.fallback {
+		if _, ok := l.translations[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Format translates a key and fills in its %s and %1$s arguments. Unknown keys
+// are formatted as they are, like in the vanilla client.
+func (l *Locale) Format(key string, args ...any) string {
+	format, ok := "", false
+	for c := l; c != nil && !ok; c = c.fallback {
+		format, ok = c.translations[key]
+	}
+	if !ok {
+		format = key
+	}
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = fmt.Sprint(arg)
+	}
+	return Sprintf(format, strs...)
+}
+
+// Format translates a key to English and fills in its arguments.
+func Format(key string, args ...any) string {
+	return English.Format(key, args...)
+}
+
+// Loader loads locales from <code>.json files, such as the minecraft/lang
+// directory of a resource pack, and caches their translations. It is safe for
+// concurrent use.
+type Loader struct {
+	fsys fs.FS
+
+	mu           sync.Mutex
+	translations map[string]map[string]string
+}
+
+// NewLoader returns a loader that reads <code>.json files from the root of fsys.
+func NewLoader(fsys fs.FS) *Loader {
+	return &Loader{fsys: fsys, translations: make(map[string]map[string]string)}
+}
+
+// Locale returns a locale by code, case-insensitively, as sent in
+// C2SClientInformationConfiguration. Regional variants fall back to the main
+// locale of their language if there is one (e.g. de_at to de_de), and every
+// locale falls back to English. The error wraps fs.ErrNotExist if there is no
+// file for the code.
+func (ld *Loader) Locale(code string) (*Locale, error) {
+	code = strings.ToLower(code)
+	main := ""
+	if lang, _, ok := strings.Cut(code, "_"); ok {
+		main = lang + "_" + lang
+	}
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	fallback := English
+	if main != "" && main != code && main != English.Code {
+		t, err := ld.load(main)
+		switch {
+		case err == nil:
+			fallback = &Locale{Code: main, translations: t, fallback: English}
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, err
+		}
+	}
+	return ld.locale(code, fallback)
+}
+
+// Chain returns a locale that falls back through the given codes in order, then
+// English. Missing files are an error.
+func (ld *Loader) Chain(codes ...string) (*Locale, error) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	l := English
+	for i := len(codes) - 1; i >= 0; i-- {
+		var err error
+		if l, err = ld.locale(strings.ToLower(codes[i]), l); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+func (ld *Loader) locale(code string, fallback *Locale) (*Locale, error) {
+	if code == English.Code {
+		return English, nil
+	}
+	t, err := ld.load(code)
+	if err != nil {
+		return nil, err
+	}
+	return &Locale{Code: code, translations: t, fallback: fallback}, nil
+}
+
+// load reads the translations of a locale, or returns the cached ones.
+func (ld *Loader) load(code string) (map[string]string, error) {
+	if t, ok := ld.translations[code]; ok {
+		return t, nil
+	}
+	data, err := fs.ReadFile(ld.fsys, code+".json")
+	if err != nil {
+		return nil, fmt.Errorf("lang: locale %s: %w", code, err)
+	}
+	var t map[string]string
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("lang: locale %s: %w", code, err)
+	}
+	ld.translations[code] = t
+	return t, nil
+}
+
+// assetIndex is the format of assets/indexes/<version>.json.
+type assetIndex struct {
+	Objects map[string]struct {
+		Hash string `json:"hash"`
+	} `json:"objects"`
+}
+
+// AssetsFS returns the minecraft/lang files of a client assets directory (e.g.
+// ~/.minecraft/assets) as a filesystem of <code>.json files, using the asset
+// index of a version (e.g. "29" from the version's assetIndex). The en_us file
+// ships in the client jar instead, so it is not included; English is embedded.
+func AssetsFS(assetsDir, index string) (fs.FS, error) {
+	data, err := os.ReadFile(filepath.Join(assetsDir, "indexes", index+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("lang: %w", err)
+	}
+	var idx assetIndex
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("lang: asset index %s: %w", index, err)
+	}
+	files := make(map[string]string)
+	for name, obj := range idx.Objects {
+		if dir, file := path.Split(name); dir == "minecraft/lang/" && len(obj.Hash) > 2 {
+			files[file] = path.Join(obj.Hash[:2], obj.Hash)
+		}
+	}
+	return &assetsFS{objects: os.DirFS(filepath.Join(assetsDir, "objects")), files: files}, nil
+}
+
+// assetsFS maps lang file names to hashed asset objects.
+type assetsFS struct {
+	objects fs.FS
+	files   map[string]string
+}
+
+func (a *assetsFS) Open(name string) (fs.File, error) {
+	object, ok := a.files[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return a.objects.Open(object)
+}
@@ -0,0 +1,139 @@
+package lang_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/go-mclib/data/pkg/data/lang"
+)
+
+var langFS = fstest.MapFS{
+	"de_de.json": {Data: []byte(`{"menu.quit": "Spiel beenden", "chat.type.text": "<%s> %s", "block.minecraft.stone": "Stein"}`)},
+	"de_at.json": {Data: []byte(`{"block.minecraft.stone": "Stoa"}`)},
+	"fr_ca.json": {Data: []byte(`{"menu.quit": "Quitter le jeu"}`)},
+	"xx_xx.json": {Data: []byte(`not json`)},
+}
+
+func TestLocaleFallbacks(t *testing.T) {
+	ld := lang.NewLoader(langFS)
+	at, err := ld.Locale("de_AT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, want := range map[string]string{
+		"block.minecraft.stone":     "Stoa",          // de_at
+		"menu.quit":                 "Spiel beenden", // de_de
+		"item.minecraft.iron_sword": "Iron Sword",    // en_us
+		"no.such.key":               "",
+	} {
+		if got := at.Translate(key); got != want {
+			t.Errorf("Translate(%q) = %q, want %q", key, got, want)
+		}
+	}
+	if at.Code != "de_at" || at.Fallback().Code != "de_de" || at.Fallback().Fallback() != lang.English {
+		t.Errorf("unexpected chain %s -> %s -> %s", at.Code, at.Fallback().Code, at.Fallback().Fallback().Code)
+	}
+
+	// fr_fr doesn't exist, so fr_ca falls back to English directly
+	ca, err := ld.Locale("fr_ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ca.Fallback() != lang.English {
+		t.Errorf("fr_ca falls back to %s", ca.Fallback().Code)
+	}
+
+	if l, err := ld.Locale("en_us"); err != nil || l != lang.English {
+		t.Errorf("Locale(en_us) = %v, %v", l, err)
+	}
+	if _, err := ld.Locale("ja_jp"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Locale(ja_jp) error = %v, want fs.ErrNotExist", err)
+	}
+	if _, err := ld.Locale("xx_xx"); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+
+	chain, err := ld.Chain("fr_ca", "de_de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := chain.Translate("block.minecraft.stone"); got != "Stein" {
+		t.Errorf("Chain Translate = %q, want Stein", got)
+	}
+	if _, err := ld.Chain("de_de", "ja_jp"); err == nil {
+		t.Error("expected an error for a missing locale in the chain")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		args   []string
+		want   string
+	}{
+		{"<%s> %s", []string{"Steve", "hi"}, "<Steve> hi"},
+		{"%2$s then %1$s", []string{"a", "b"}, "b then a"},
+		{"100%% %s", []string{"done"}, "100% done"},
+		{"%s and %s", []string{"one"}, "%s and %s"},
+		{"%d items", []string{"3"}, "%d items"},
+		{"50% off", nil, "50% off"},
+	}
+	for _, tt := range tests {
+		if got := lang.Sprintf(tt.format, tt.args...); got != tt.want {
+			t.Errorf("Sprintf(%q, %q) = %q, want %q", tt.format, tt.args, got, tt.want)
+		}
+	}
+
+	de, err := lang.NewLoader(langFS).Locale("de_de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := de.Format("chat.type.text", "Steve", 42); got != "<Steve> 42" {
+		t.Errorf("Format = %q", got)
+	}
+	if got := lang.Format("commands.message.display.incoming", "Alex", "psst"); got != "Alex whispers to you: psst" {
+		t.Errorf("Format = %q", got)
+	}
+	if got := lang.Format("no.such.key"); got != "no.such.key" {
+		t.Errorf("Format of an unknown key = %q", got)
+	}
+}
+
+func TestAssetsFS(t *testing.T) {
+	dir := t.TempDir()
+	hash := "ab12cd34ef56ab12cd34ef56ab12cd34ef56ab12"
+	write := func(name, data string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("indexes/29.json", `{"objects": {
+		"minecraft/lang/de_de.json": {"hash": "`+hash+`", "size": 40},
+		"minecraft/sounds/ambient/cave/cave1.ogg": {"hash": "ffff", "size": 1}
+	}}`)
+	write("objects/ab/"+hash, `{"menu.quit": "Spiel beenden"}`)
+
+	fsys, err := lang.AssetsFS(dir, "29")
+	if err != nil {
+		t.Fatal(err)
+	}
+	de, err := lang.NewLoader(fsys).Locale("de_de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := de.Translate("menu.quit"); got != "Spiel beenden" {
+		t.Errorf("Translate = %q", got)
+	}
+	if _, err := fsys.Open("cave1.ogg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open(cave1.ogg) error = %v", err)
+	}
+}
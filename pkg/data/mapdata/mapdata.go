@@ -0,0 +1,122 @@
+// Package mapdata accumulates map item data from S2CMapItemData packets
+// and renders maps to images using the vanilla map color table.
+package mapdata
+
+import (
+	"cmp"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"slices"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Size is the width and height of a map in pixels.
+const Size = 128
+
+// Color returns the RGBA color of a packed map color (base color * 4 + brightness).
+// Base color 0 and unknown colors are transparent.
+func Color(packed byte) color.RGBA {
+	if int(packed) >= len(mapColors) {
+		return color.RGBA{}
+	}
+	return mapColors[packed]
+}
+
+// BaseColorName returns the lowercase MapColor name of a packed map color
+// (e.g. "grass"), or empty if unknown.
+func BaseColorName(packed byte) string {
+	if int(packed/4) >= len(baseColorNames) {
+		return ""
+	}
+	return baseColorNames[packed/4]
+}
+
+// Map is the state of a single map item.
+type Map struct {
+	ID          int32
+	Scale       int8
+	Locked      bool
+	Decorations []packets.MapDecoration
+	// Colors are packed map colors, row by row.
+	Colors [Size * Size]byte
+}
+
+// Apply applies a map data update: scale, lock, decorations and the color patch.
+func (m *Map) Apply(p *packets.S2CMapItemData) {
+	m.Scale = int8(p.Scale)
+	m.Locked = bool(p.Locked)
+	if decorations, ok := p.Decorations.Get(); ok {
+		m.Decorations = slices.Clone(decorations)
+	}
+	if patch := p.ColorPatch; patch != nil {
+		for y := range int(patch.Height) {
+			for x := range int(patch.Width) {
+				i := x + y*int(patch.Width)
+				px, py := int(patch.StartX)+x, int(patch.StartY)+y
+				if i >= len(patch.Colors) || px >= Size || py >= Size {
+					continue
+				}
+				m.Colors[px+py*Size] = patch.Colors[i]
+			}
+		}
+	}
+}
+
+// Image renders the map to a 128x128 image.
+func (m *Map) Image() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, Size, Size))
+	for i, c := range m.Colors {
+		img.SetRGBA(i%Size, i/Size, Color(c))
+	}
+	return img
+}
+
+// WritePNG encodes the rendered map as PNG.
+func (m *Map) WritePNG(w io.Writer) error {
+	return png.Encode(w, m.Image())
+}
+
+// MapCanvas accumulates map data updates per map ID.
+type MapCanvas struct {
+	maps map[int32]*Map
+}
+
+// NewMapCanvas creates an empty MapCanvas.
+func NewMapCanvas() *MapCanvas {
+	return &MapCanvas{maps: make(map[int32]*Map)}
+}
+
+// Apply applies a map data update, creating the map if it is new.
+func (c *MapCanvas) Apply(p *packets.S2CMapItemData) *Map {
+	id := int32(p.MapId)
+	m := c.maps[id]
+	if m == nil {
+		m = &Map{ID: id}
+		c.maps[id] = m
+	}
+	m.Apply(p)
+	return m
+}
+
+// Map returns the map with the given ID, or nil if unknown.
+func (c *MapCanvas) Map(id int32) *Map {
+	return c.maps[id]
+}
+
+// Maps returns all known maps, sorted by ID.
+func (c *MapCanvas) Maps() []*Map {
+	maps := make([]*Map, 0, len(c.maps))
+	for _, m := range c.maps {
+		maps = append(maps, m)
+	}
+	slices.SortFunc(maps, func(a, b *Map) int { return cmp.Compare(a.ID, b.ID) })
+	return maps
+}
+
+// Remove forgets a map.
+func (c *MapCanvas) Remove(id int32) {
+	delete(c.maps, id)
+}
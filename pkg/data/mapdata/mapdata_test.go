@@ -0,0 +1,79 @@
+package mapdata_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/mapdata"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColor(t *testing.T) {
+	assert.Equal(t, color.RGBA{}, mapdata.Color(0))
+	assert.Equal(t, color.RGBA{}, mapdata.Color(3))
+	// grass, all four shades
+	assert.Equal(t, color.RGBA{89, 125, 39, 255}, mapdata.Color(1*4+mapdata.BrightnessLow))
+	assert.Equal(t, color.RGBA{109, 153, 48, 255}, mapdata.Color(1*4+mapdata.BrightnessNormal))
+	assert.Equal(t, color.RGBA{127, 178, 56, 255}, mapdata.Color(1*4+mapdata.BrightnessHigh))
+	assert.Equal(t, color.RGBA{67, 94, 29, 255}, mapdata.Color(1*4+mapdata.BrightnessLowest))
+	assert.Equal(t, "water", mapdata.BaseColorName(12*4+2))
+	assert.Equal(t, color.RGBA{}, mapdata.Color(255))
+	assert.Empty(t, mapdata.BaseColorName(255))
+}
+
+func TestMapCanvas(t *testing.T) {
+	canvas := mapdata.NewMapCanvas()
+
+	full := make([]byte, mapdata.Size*mapdata.Size)
+	for i := range full {
+		full[i] = 12*4 + mapdata.BrightnessNormal // water
+	}
+	first := &packets.S2CMapItemData{
+		MapId:  3,
+		Scale:  1,
+		Locked: true,
+		Decorations: ns.Some(ns.PrefixedArray[packets.MapDecoration]{
+			{Type: 0, X: -10, Y: 20, Rotation: 8, DisplayName: ns.Some(ns.TextComponent{Text: "Home"})},
+		}),
+		ColorPatch: &packets.MapColorPatch{Width: 128, Height: 128, Colors: full},
+	}
+	m := canvas.Apply(first)
+	assert.Equal(t, int32(3), m.ID)
+	assert.Equal(t, int8(1), m.Scale)
+	assert.True(t, m.Locked)
+	require.Len(t, m.Decorations, 1)
+	assert.Equal(t, "Home", m.Decorations[0].DisplayName.Value.Text)
+
+	// a 2x1 patch at (10, 20); decorations are kept when not sent
+	canvas.Apply(&packets.S2CMapItemData{
+		MapId:      3,
+		Scale:      1,
+		Locked:     true,
+		ColorPatch: &packets.MapColorPatch{Width: 2, Height: 1, StartX: 10, StartY: 20, Colors: []byte{1*4 + 2, 0}},
+	})
+	m = canvas.Map(3)
+	require.NotNil(t, m)
+	assert.Len(t, m.Decorations, 1)
+
+	img := m.Image()
+	assert.Equal(t, color.RGBA{127, 178, 56, 255}, img.RGBAAt(10, 20))
+	assert.Equal(t, color.RGBA{}, img.RGBAAt(11, 20))
+	assert.Equal(t, mapdata.Color(12*4+mapdata.BrightnessNormal), img.RGBAAt(12, 20))
+
+	var out bytes.Buffer
+	require.NoError(t, m.WritePNG(&out))
+	decoded, err := png.Decode(&out)
+	require.NoError(t, err)
+	assert.Equal(t, 128, decoded.Bounds().Dx())
+	r, g, b, a := decoded.At(10, 20).RGBA()
+	assert.Equal(t, []uint32{127, 178, 56, 255}, []uint32{r >> 8, g >> 8, b >> 8, a >> 8})
+
+	assert.Len(t, canvas.Maps(), 1)
+	canvas.Remove(3)
+	assert.Nil(t, canvas.Map(3))
+}
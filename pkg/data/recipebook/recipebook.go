@@ -0,0 +1,211 @@
+// Package recipebook tracks the recipe book from S2CRecipeBookAdd,
+// S2CRecipeBookRemove, S2CRecipeBookSettings and S2CUpdateRecipes packets.
+package recipebook
+
+import (
+	"cmp"
+	"slices"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Recipe is a recipe known to the client.
+type Recipe struct {
+	// ID is the recipe display ID, as used by C2SPlaceRecipe.
+	ID      int32
+	Display packets.RecipeDisplay
+	// Group is the recipe group ID, or -1 if the recipe is not grouped.
+	Group int32
+	// Category is the recipe book category, e.g. "minecraft:crafting_misc".
+	Category string
+	// Requirements lists the ingredients checked to decide whether the recipe is craftable.
+	Requirements []ns.IDSet
+	Notification bool
+	// Highlighted recipes are new and not yet seen in the recipe book.
+	Highlighted bool
+}
+
+// RecipeBook tracks the recipes known to the client.
+type RecipeBook struct {
+	Settings     packets.S2CRecipeBookSettings
+	recipes      map[int32]*Recipe
+	propertySets map[string][]int32
+	stonecutter  []packets.StonecutterRecipe
+}
+
+// NewRecipeBook creates an empty RecipeBook.
+func NewRecipeBook() *RecipeBook {
+	return &RecipeBook{
+		recipes:      make(map[int32]*Recipe),
+		propertySets: make(map[string][]int32),
+	}
+}
+
+// ApplyAdd adds recipes to the book, replacing all known recipes if the packet says so.
+func (b *RecipeBook) ApplyAdd(p *packets.S2CRecipeBookAdd) {
+	if p.Replace {
+		clear(b.recipes)
+	}
+	for _, e := range p.Entries {
+		r := &Recipe{
+			ID:           int32(e.Id),
+			Display:      e.Display,
+			Group:        int32(e.Group),
+			Category:     registries.RecipeBookCategory.ByID(int32(e.Category)),
+			Notification: e.Flags&packets.RecipeBookShowNotification != 0,
+			Highlighted:  e.Flags&packets.RecipeBookHighlight != 0,
+		}
+		if reqs, ok := e.CraftingRequirements.Get(); ok {
+			r.Requirements = slices.Clone(reqs)
+		}
+		b.recipes[r.ID] = r
+	}
+}
+
+// ApplyRemove removes recipes from the book.
+func (b *RecipeBook) ApplyRemove(p *packets.S2CRecipeBookRemove) {
+	for _, id := range p.Recipes {
+		delete(b.recipes, int32(id))
+	}
+}
+
+// ApplySettings stores the recipe book settings.
+func (b *RecipeBook) ApplySettings(p *packets.S2CRecipeBookSettings) {
+	b.Settings = *p
+}
+
+// ApplyUpdateRecipes replaces the property sets and stonecutter recipes.
+func (b *RecipeBook) ApplyUpdateRecipes(p *packets.S2CUpdateRecipes) {
+	clear(b.propertySets)
+	for _, s := range p.PropertySets {
+		ids := make([]int32, len(s.Items))
+		for i, id := range s.Items {
+			ids[i] = int32(id)
+		}
+		b.propertySets[string(s.Id)] = ids
+	}
+	b.stonecutter = slices.Clone(p.StonecutterRecipes)
+}
+
+// Recipe returns the recipe with the given display ID, or nil if unknown.
+func (b *RecipeBook) Recipe(id int32) *Recipe {
+	return b.recipes[id]
+}
+
+// Len returns the number of known recipes.
+func (b *RecipeBook) Len() int {
+	return len(b.recipes)
+}
+
+// Recipes returns all known recipes, sorted by ID.
+func (b *RecipeBook) Recipes() []*Recipe {
+	return b.filter(func(*Recipe) bool { return true })
+}
+
+// Category returns the recipes of a recipe book category, sorted by ID.
+func (b *RecipeBook) Category(category string) []*Recipe {
+	return b.filter(func(r *Recipe) bool { return r.Category == category })
+}
+
+// Highlighted returns the recipes not yet seen in the recipe book, sorted by ID.
+func (b *RecipeBook) Highlighted() []*Recipe {
+	return b.filter(func(r *Recipe) bool { return r.Highlighted })
+}
+
+// ByResult returns the recipes whose result display shows the given item, sorted by ID.
+func (b *RecipeBook) ByResult(itemID int32) []*Recipe {
+	return b.filter(func(r *Recipe) bool {
+		return slices.Contains(SlotDisplayItems(Result(r.Display)), itemID)
+	})
+}
+
+// MarkSeen clears the highlight of a recipe and returns the packet that tells the server.
+// It returns nil if the recipe is unknown.
+func (b *RecipeBook) MarkSeen(id int32) *packets.C2SRecipeBookSeenRecipe {
+	r := b.recipes[id]
+	if r == nil {
+		return nil
+	}
+	r.Highlighted = false
+	return &packets.C2SRecipeBookSeenRecipe{RecipeId: ns.VarInt(id)}
+}
+
+// PropertySet returns the item IDs of a recipe property set (e.g. "minecraft:furnace_input"),
+// or nil if unknown.
+func (b *RecipeBook) PropertySet(id string) []int32 {
+	return b.propertySets[id]
+}
+
+// StonecutterRecipes returns the stonecutter recipes, in the order sent by the server.
+func (b *RecipeBook) StonecutterRecipes() []packets.StonecutterRecipe {
+	return b.stonecutter
+}
+
+func (b *RecipeBook) filter(keep func(*Recipe) bool) []*Recipe {
+	var result []*Recipe
+	for _, r := range b.recipes {
+		if keep(r) {
+			result = append(result, r)
+		}
+	}
+	slices.SortFunc(result, func(x, y *Recipe) int { return cmp.Compare(x.ID, y.ID) })
+	return result
+}
+
+// Result returns the result slot display of a recipe display, or nil if unknown.
+func Result(d packets.RecipeDisplay) packets.SlotDisplay {
+	switch d := d.(type) {
+	case *packets.ShapelessCraftingRecipeDisplay:
+		return d.Result
+	case *packets.ShapedCraftingRecipeDisplay:
+		return d.Result
+	case *packets.FurnaceRecipeDisplay:
+		return d.Result
+	case *packets.StonecutterRecipeDisplay:
+		return d.Result
+	case *packets.SmithingRecipeDisplay:
+		return d.Result
+	}
+	return nil
+}
+
+// SlotDisplayItems returns the item IDs a slot display can show, without duplicates.
+// Tags and fuel lists are not resolved and contribute no items.
+func SlotDisplayItems(d packets.SlotDisplay) []int32 {
+	var ids []int32
+	var walk func(packets.SlotDisplay)
+	add := func(id int32) {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	walk = func(d packets.SlotDisplay) {
+		switch d := d.(type) {
+		case *packets.ItemSlotDisplay:
+			add(int32(d.Item))
+		case *packets.ItemStackSlotDisplay:
+			if d.Stack != nil && !d.Stack.IsEmpty() {
+				add(d.Stack.ID)
+			}
+		case *packets.WithAnyPotionSlotDisplay:
+			walk(d.Display)
+		case *packets.OnlyWithComponentSlotDisplay:
+			walk(d.Display)
+		case *packets.DyedSlotDisplay:
+			walk(d.Target)
+		case *packets.SmithingTrimSlotDisplay:
+			walk(d.Base)
+		case *packets.WithRemainderSlotDisplay:
+			walk(d.Input)
+		case *packets.CompositeSlotDisplay:
+			for _, c := range d.Contents {
+				walk(c)
+			}
+		}
+	}
+	walk(d)
+	return ids
+}
@@ -0,0 +1,125 @@
+package recipebook_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/data/recipebook"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	stick       = items.ItemID("minecraft:stick")
+	planks      = items.ItemID("minecraft:oak_planks")
+	craftTable  = items.ItemID("minecraft:crafting_table")
+	furnace     = items.ItemID("minecraft:furnace")
+	ironIngot   = items.ItemID("minecraft:iron_ingot")
+	rawIron     = items.ItemID("minecraft:raw_iron")
+	stone       = items.ItemID("minecraft:stone")
+	stoneSlab   = items.ItemID("minecraft:stone_slab")
+	stonecutter = items.ItemID("minecraft:stonecutter")
+)
+
+func item(id int32) *packets.ItemSlotDisplay {
+	return &packets.ItemSlotDisplay{Item: ns.VarInt(id)}
+}
+
+func TestRecipeBook(t *testing.T) {
+	book := recipebook.NewRecipeBook()
+
+	add := &packets.S2CRecipeBookAdd{
+		Entries: ns.PrefixedArray[packets.RecipeBookEntry]{
+			{
+				Id: 7,
+				Display: &packets.ShapedCraftingRecipeDisplay{
+					Width: 1, Height: 2,
+					Ingredients:     []packets.SlotDisplay{item(planks), item(planks)},
+					Result:          &packets.ItemStackSlotDisplay{Stack: items.NewStackWithComponents(stick, 4, &items.Components{})},
+					CraftingStation: item(craftTable),
+				},
+				Group:    -1,
+				Category: 3, // crafting_misc
+				CraftingRequirements: ns.Some(ns.PrefixedArray[ns.IDSet]{
+					*ns.NewInlineIDSet([]ns.VarInt{ns.VarInt(planks)}),
+					*ns.NewTagIDSet("minecraft:planks"),
+				}),
+				Flags: packets.RecipeBookShowNotification | packets.RecipeBookHighlight,
+			},
+			{
+				Id: 2,
+				Display: &packets.FurnaceRecipeDisplay{
+					Ingredient:      item(rawIron),
+					Fuel:            &packets.AnyFuelSlotDisplay{},
+					Result:          item(ironIngot),
+					CraftingStation: item(furnace),
+					Duration:        200,
+					Experience:      0.7,
+				},
+				Group:    4,
+				Category: 6, // furnace_misc
+			},
+		},
+		Replace: true,
+	}
+	book.ApplyAdd(add)
+	require.Equal(t, 2, book.Len())
+
+	sticks := book.Recipe(7)
+	require.NotNil(t, sticks)
+	result, ok := recipebook.Result(sticks.Display).(*packets.ItemStackSlotDisplay)
+	require.True(t, ok)
+	assert.Equal(t, int32(4), result.Stack.Count)
+	assert.Equal(t, "minecraft:crafting_misc", sticks.Category)
+	assert.True(t, sticks.Highlighted)
+	assert.True(t, sticks.Notification)
+	require.Len(t, sticks.Requirements, 2)
+	assert.True(t, sticks.Requirements[1].IsTag)
+
+	byResult := book.ByResult(stick)
+	require.Len(t, byResult, 1)
+	assert.Equal(t, int32(7), byResult[0].ID)
+	assert.Len(t, book.ByResult(ironIngot), 1)
+	assert.Empty(t, book.ByResult(stone))
+	assert.Len(t, book.Category("minecraft:furnace_misc"), 1)
+
+	assert.Len(t, book.Highlighted(), 1)
+	seen := book.MarkSeen(7)
+	require.NotNil(t, seen)
+	assert.Equal(t, ns.VarInt(7), seen.RecipeId)
+	assert.Empty(t, book.Highlighted())
+	assert.Nil(t, book.MarkSeen(99))
+
+	book.ApplyRemove(&packets.S2CRecipeBookRemove{Recipes: ns.PrefixedArray[ns.VarInt]{2}})
+	assert.Nil(t, book.Recipe(2))
+	assert.Equal(t, 1, book.Len())
+
+	// without replace, entries are added to the known recipes
+	book.ApplyAdd(&packets.S2CRecipeBookAdd{
+		Entries: ns.PrefixedArray[packets.RecipeBookEntry]{
+			{Id: 9, Display: &packets.StonecutterRecipeDisplay{Input: item(stone), Result: item(stoneSlab), CraftingStation: item(stonecutter)}, Group: -1},
+		},
+	})
+	ids := func(rs []*recipebook.Recipe) []int32 {
+		var result []int32
+		for _, r := range rs {
+			result = append(result, r.ID)
+		}
+		return result
+	}
+	assert.Equal(t, []int32{7, 9}, ids(book.Recipes()))
+
+	book.ApplyUpdateRecipes(&packets.S2CUpdateRecipes{
+		PropertySets: ns.PrefixedArray[packets.RecipePropertySet]{
+			{Id: "minecraft:furnace_input", Items: ns.PrefixedArray[ns.VarInt]{ns.VarInt(rawIron)}},
+		},
+		StonecutterRecipes: ns.PrefixedArray[packets.StonecutterRecipe]{
+			{Input: *ns.NewInlineIDSet([]ns.VarInt{ns.VarInt(stone)}), Display: item(stoneSlab)},
+		},
+	})
+	assert.Equal(t, []int32{rawIron}, book.PropertySet("minecraft:furnace_input"))
+	require.Len(t, book.StonecutterRecipes(), 1)
+	assert.Equal(t, []int32{stoneSlab}, recipebook.SlotDisplayItems(book.StonecutterRecipes()[0].Display))
+}
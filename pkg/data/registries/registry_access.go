@@ -1,8 +1,10 @@
 package registries
 
-import "maps"
-
-import "fmt"
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
 
 var synchronizedSet = func() map[string]struct{} {
 	m := make(map[string]struct{}, len(SynchronizedRegistryIDs))
@@ -25,14 +27,23 @@ func IsSynchronized(id string) bool {
 // populated from S2CRegistryData packets during the configuration phase.
 type RegistryAccess struct {
 	registries map[string]*Registry
+	tags       map[string]*registryTags
+}
+
+// registryTags holds the tags of a registry, indexed both ways.
+type registryTags struct {
+	entriesByTag map[string][]string
+	tagsByEntry  map[string][]string
 }
 
 // NewRegistryAccess creates a RegistryAccess initialized with vanilla defaults.
 // Static registries share the global instances (cheap).
 // Synchronized registries start empty and must be populated via ApplyRegistryData.
+// Tags start empty and are populated via ApplyTags or LoadVanillaTags.
 func NewRegistryAccess() *RegistryAccess {
 	ra := &RegistryAccess{
 		registries: make(map[string]*Registry, len(ByIdentifier)+len(SynchronizedRegistryIDs)),
+		tags:       make(map[string]*registryTags),
 	}
 	// add static registries (shared, immutable)
 	maps.Copy(ra.registries, ByIdentifier)
@@ -61,3 +72,82 @@ func (ra *RegistryAccess) ApplyRegistryData(registryID string, entryIDs []string
 	ra.registries[registryID] = reg
 	return reg, nil
 }
+
+// ApplyTags replaces the tags of a registry from S2CUpdateTags data. tags maps
+// tag names (e.g. "minecraft:mineable/pickaxe") to entry protocol IDs, which are
+// resolved against the registry, so synchronized registries must be populated first.
+func (ra *RegistryAccess) ApplyTags(registryID string, tags map[string][]int32) error {
+	reg := ra.registries[registryID]
+	if reg == nil {
+		return fmt.Errorf("registries: unknown registry %q", registryID)
+	}
+	named := make(map[string][]string, len(tags))
+	for tag, ids := range tags {
+		entries := make([]string, len(ids))
+		for i, id := range ids {
+			if entries[i] = reg.ByID(id); entries[i] == "" {
+				return fmt.Errorf("registries: unknown entry %d in tag %s of %q", id, tag, registryID)
+			}
+		}
+		named[tag] = entries
+	}
+	ra.tags[registryID] = newRegistryTags(named)
+	return nil
+}
+
+// LoadVanillaTags sets the tags of every registry to the vanilla tags from TagData,
+// for use without a server connection.
+func (ra *RegistryAccess) LoadVanillaTags() {
+	for registryID, tags := range TagData {
+		ra.tags[registryID] = newRegistryTags(tags)
+	}
+}
+
+func newRegistryTags(tags map[string][]string) *registryTags {
+	rt := &registryTags{
+		entriesByTag: make(map[string][]string, len(tags)),
+		tagsByEntry:  make(map[string][]string),
+	}
+	for tag, entries := range tags {
+		rt.entriesByTag[tag] = slices.Clone(entries)
+		for _, entry := range entries {
+			rt.tagsByEntry[entry] = append(rt.tagsByEntry[entry], tag)
+		}
+	}
+	for _, tags := range rt.tagsByEntry {
+		slices.Sort(tags)
+	}
+	return rt
+}
+
+// HasTag reports whether an entry belongs to a tag of a registry, e.g.
+// HasTag("minecraft:block", "minecraft:mineable/pickaxe", "minecraft:stone").
+func (ra *RegistryAccess) HasTag(registryID, tag, entry string) bool {
+	rt := ra.tags[registryID]
+	return rt != nil && slices.Contains(rt.tagsByEntry[entry], tag)
+}
+
+// Tag returns the entries of a tag of a registry, or nil if the tag is unknown.
+func (ra *RegistryAccess) Tag(registryID, tag string) []string {
+	if rt := ra.tags[registryID]; rt != nil {
+		return rt.entriesByTag[tag]
+	}
+	return nil
+}
+
+// Tags returns the sorted tag names of a registry.
+func (ra *RegistryAccess) Tags(registryID string) []string {
+	rt := ra.tags[registryID]
+	if rt == nil {
+		return nil
+	}
+	return slices.Sorted(maps.Keys(rt.entriesByTag))
+}
+
+// EntryTags returns the sorted tags an entry of a registry belongs to.
+func (ra *RegistryAccess) EntryTags(registryID, entry string) []string {
+	if rt := ra.tags[registryID]; rt != nil {
+		return rt.tagsByEntry[entry]
+	}
+	return nil
+}
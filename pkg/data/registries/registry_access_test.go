@@ -147,3 +147,71 @@ func TestApplyPreservesIdentifier(t *testing.T) {
 		t.Errorf("applied registry Identifier = %q, want minecraft:dimension_type", reg.Identifier)
 	}
 }
+
+func TestApplyTags(t *testing.T) {
+	ra := registries.NewRegistryAccess()
+	if ra.HasTag("minecraft:block", "minecraft:mineable/pickaxe", "minecraft:stone") {
+		t.Error("tags should start empty")
+	}
+
+	stone := registries.Block.Get("minecraft:stone")
+	dirt := registries.Block.Get("minecraft:dirt")
+	err := ra.ApplyTags("minecraft:block", map[string][]int32{
+		"minecraft:mineable/pickaxe": {stone},
+		"custom:soft":                {dirt, stone},
+	})
+	if err != nil {
+		t.Fatalf("ApplyTags: %v", err)
+	}
+
+	if !ra.HasTag("minecraft:block", "minecraft:mineable/pickaxe", "minecraft:stone") {
+		t.Error("stone should be mineable with a pickaxe")
+	}
+	if ra.HasTag("minecraft:block", "minecraft:mineable/pickaxe", "minecraft:dirt") {
+		t.Error("dirt should not be mineable with a pickaxe")
+	}
+	if got := ra.Tag("minecraft:block", "custom:soft"); len(got) != 2 || got[0] != "minecraft:dirt" {
+		t.Errorf("Tag(custom:soft) = %v, want [minecraft:dirt minecraft:stone]", got)
+	}
+	if got := ra.EntryTags("minecraft:block", "minecraft:stone"); len(got) != 2 || got[0] != "custom:soft" {
+		t.Errorf("EntryTags(stone) = %v, want [custom:soft minecraft:mineable/pickaxe]", got)
+	}
+	if got := ra.Tags("minecraft:block"); len(got) != 2 {
+		t.Errorf("Tags(block) = %v, want 2 tags", got)
+	}
+
+	// a new update replaces the previous tags of the registry
+	if err := ra.ApplyTags("minecraft:block", map[string][]int32{"custom:soft": {dirt}}); err != nil {
+		t.Fatalf("ApplyTags: %v", err)
+	}
+	if ra.HasTag("minecraft:block", "minecraft:mineable/pickaxe", "minecraft:stone") {
+		t.Error("tags missing from the update should be removed")
+	}
+}
+
+func TestApplyTagsSynchronized(t *testing.T) {
+	ra := registries.NewRegistryAccess()
+	ra.ApplyRegistryData("minecraft:worldgen/biome", []string{"custom:biome_a", "minecraft:plains"})
+
+	if err := ra.ApplyTags("minecraft:worldgen/biome", map[string][]int32{"minecraft:is_overworld": {0, 1}}); err != nil {
+		t.Fatalf("ApplyTags: %v", err)
+	}
+	if !ra.HasTag("minecraft:worldgen/biome", "minecraft:is_overworld", "custom:biome_a") {
+		t.Error("custom:biome_a should be in minecraft:is_overworld")
+	}
+
+	if err := ra.ApplyTags("minecraft:worldgen/biome", map[string][]int32{"minecraft:is_overworld": {5}}); err == nil {
+		t.Error("expected error for unknown entry ID")
+	}
+	if err := ra.ApplyTags("minecraft:nonexistent", nil); err == nil {
+		t.Error("expected error for unknown registry")
+	}
+}
+
+func TestLoadVanillaTags(t *testing.T) {
+	ra := registries.NewRegistryAccess()
+	ra.LoadVanillaTags()
+	if !ra.HasTag("minecraft:block", "minecraft:acacia_logs", "minecraft:acacia_log") {
+		t.Error("vanilla tags should include minecraft:acacia_logs")
+	}
+}
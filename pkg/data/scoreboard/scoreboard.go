@@ -0,0 +1,402 @@
+// Package scoreboard tracks objectives, scores and teams from S2CSetObjective,
+// S2CSetScore, S2CResetScore, S2CSetPlayerTeam and S2CSetDisplayObjective packets.
+package scoreboard
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// SidebarMaxLines is the number of scores the client shows in the sidebar.
+const SidebarMaxLines = 15
+
+// team colors by ChatFormatting ID
+var colorNames = [16]string{
+	"black", "dark_blue", "dark_green", "dark_aqua", "dark_red", "dark_purple", "gold", "gray",
+	"dark_gray", "blue", "green", "aqua", "red", "light_purple", "yellow", "white",
+}
+
+// Objective is a scoreboard objective.
+type Objective struct {
+	Name        string
+	DisplayName ns.TextComponent
+	// RenderType is packets.ObjectiveRenderInteger or packets.ObjectiveRenderHearts.
+	RenderType int32
+	// NumberFormat is the default format of the objective's scores, or nil for the client default.
+	NumberFormat *packets.NumberFormat
+}
+
+// Score is the score of an owner (player name or entity UUID) for an objective.
+type Score struct {
+	Owner     string
+	Objective string
+	Value     int32
+	// DisplayName replaces the owner name, or nil.
+	DisplayName *ns.TextComponent
+	// NumberFormat overrides the objective's number format, or nil.
+	NumberFormat *packets.NumberFormat
+}
+
+// Hidden reports whether the score is hidden from the sidebar (owners starting with '#').
+func (s *Score) Hidden() bool {
+	return strings.HasPrefix(s.Owner, "#")
+}
+
+// Team is a scoreboard team.
+type Team struct {
+	Name                  string
+	DisplayName           ns.TextComponent
+	FriendlyFire          bool
+	SeeFriendlyInvisibles bool
+	NameTagVisibility     int32
+	CollisionRule         int32
+	// Color is the ChatFormatting ID of the team color (0-15), or 21 for none.
+	Color   int32
+	Prefix  ns.TextComponent
+	Suffix  ns.TextComponent
+	players map[string]struct{}
+}
+
+// ColorName returns the team color name (e.g. "red"), or empty if the team has no color.
+func (t *Team) ColorName() string {
+	if t.Color < 0 || int(t.Color) >= len(colorNames) {
+		return ""
+	}
+	return colorNames[t.Color]
+}
+
+// Players returns the team members, sorted.
+func (t *Team) Players() []string {
+	players := make([]string, 0, len(t.players))
+	for p := range t.players {
+		players = append(players, p)
+	}
+	slices.Sort(players)
+	return players
+}
+
+// FormatName decorates a name with the team prefix, color and suffix, like the client does.
+// The color applies to the prefix and suffix too, unless they set their own.
+func (t *Team) FormatName(name ns.TextComponent) ns.TextComponent {
+	return ns.TextComponent{Color: t.ColorName(), Extra: []ns.TextComponent{t.Prefix, name, t.Suffix}}
+}
+
+// Scoreboard tracks the scoreboard state.
+type Scoreboard struct {
+	objectives  map[string]*Objective
+	scores      map[string]map[string]*Score // owner -> objective -> score
+	teams       map[string]*Team
+	playerTeams map[string]*Team
+	displays    map[int32]string
+}
+
+// NewScoreboard creates an empty Scoreboard.
+func NewScoreboard() *Scoreboard {
+	return &Scoreboard{
+		objectives:  make(map[string]*Objective),
+		scores:      make(map[string]map[string]*Score),
+		teams:       make(map[string]*Team),
+		playerTeams: make(map[string]*Team),
+		displays:    make(map[int32]string),
+	}
+}
+
+// ApplyObjective creates, updates or removes an objective.
+// Removing an objective also removes its scores and display slots.
+func (s *Scoreboard) ApplyObjective(p *packets.S2CSetObjective) {
+	name := string(p.ObjectiveName)
+	switch p.Mode {
+	case packets.ObjectiveModeCreate, packets.ObjectiveModeUpdate:
+		o := s.objectives[name]
+		if o == nil {
+			if p.Mode == packets.ObjectiveModeUpdate {
+				return
+			}
+			o = &Objective{Name: name}
+			s.objectives[name] = o
+		}
+		o.DisplayName = p.DisplayName
+		o.RenderType = int32(p.RenderType)
+		o.NumberFormat = nil
+		if f, ok := p.NumberFormat.Get(); ok {
+			o.NumberFormat = &f
+		}
+	case packets.ObjectiveModeRemove:
+		delete(s.objectives, name)
+		for owner, scores := range s.scores {
+			delete(scores, name)
+			if len(scores) == 0 {
+				delete(s.scores, owner)
+			}
+		}
+		for slot, objective := range s.displays {
+			if objective == name {
+				delete(s.displays, slot)
+			}
+		}
+	}
+}
+
+// ApplyScore sets a score. Scores of unknown objectives are ignored.
+func (s *Scoreboard) ApplyScore(p *packets.S2CSetScore) {
+	objective := string(p.ObjectiveName)
+	if s.objectives[objective] == nil {
+		return
+	}
+	owner := string(p.EntityName)
+	scores := s.scores[owner]
+	if scores == nil {
+		scores = make(map[string]*Score)
+		s.scores[owner] = scores
+	}
+	score := &Score{Owner: owner, Objective: objective, Value: int32(p.Value)}
+	if name, ok := p.DisplayName.Get(); ok {
+		score.DisplayName = &name
+	}
+	if f, ok := p.NumberFormat.Get(); ok {
+		score.NumberFormat = &f
+	}
+	scores[objective] = score
+}
+
+// ApplyResetScore removes a score, or all scores of the owner if no objective is given.
+func (s *Scoreboard) ApplyResetScore(p *packets.S2CResetScore) {
+	owner := string(p.EntityName)
+	objective, ok := p.ObjectiveName.Get()
+	if !ok {
+		delete(s.scores, owner)
+		return
+	}
+	if scores := s.scores[owner]; scores != nil {
+		delete(scores, string(objective))
+		if len(scores) == 0 {
+			delete(s.scores, owner)
+		}
+	}
+}
+
+// ApplyTeam creates, updates or removes a team, or changes its members.
+// Like the client, a player joining a team leaves their previous team.
+func (s *Scoreboard) ApplyTeam(p *packets.S2CSetPlayerTeam) {
+	name := string(p.TeamName)
+	t := s.teams[name]
+	switch p.Method {
+	case packets.TeamMethodCreate:
+		if t == nil {
+			t = &Team{Name: name, players: make(map[string]struct{})}
+			s.teams[name] = t
+		}
+	case packets.TeamMethodRemove:
+		if t != nil {
+			for player := range t.players {
+				delete(s.playerTeams, player)
+			}
+			delete(s.teams, name)
+		}
+		return
+	}
+	if t == nil {
+		return
+	}
+
+	if p.Method == packets.TeamMethodCreate || p.Method == packets.TeamMethodUpdate {
+		t.DisplayName = p.DisplayName
+		t.FriendlyFire = p.Options&packets.TeamFriendlyFire != 0
+		t.SeeFriendlyInvisibles = p.Options&packets.TeamSeeFriendlyInvisibles != 0
+		t.NameTagVisibility = int32(p.NameTagVisibility)
+		t.CollisionRule = int32(p.CollisionRule)
+		t.Color = int32(p.Color)
+		t.Prefix = p.Prefix
+		t.Suffix = p.Suffix
+	}
+	switch p.Method {
+	case packets.TeamMethodCreate, packets.TeamMethodAddPlayers:
+		for _, player := range p.Players {
+			if previous := s.playerTeams[string(player)]; previous != nil {
+				delete(previous.players, string(player))
+			}
+			t.players[string(player)] = struct{}{}
+			s.playerTeams[string(player)] = t
+		}
+	case packets.TeamMethodRemovePlayers:
+		for _, player := range p.Players {
+			if s.playerTeams[string(player)] == t {
+				delete(t.players, string(player))
+				delete(s.playerTeams, string(player))
+			}
+		}
+	}
+}
+
+// ApplyDisplayObjective shows an objective in a display slot, or clears the slot.
+func (s *Scoreboard) ApplyDisplayObjective(p *packets.S2CSetDisplayObjective) {
+	if s.objectives[string(p.ScoreName)] == nil {
+		delete(s.displays, int32(p.Position))
+		return
+	}
+	s.displays[int32(p.Position)] = string(p.ScoreName)
+}
+
+// Objective returns the objective with the given name, or nil if unknown.
+func (s *Scoreboard) Objective(name string) *Objective {
+	return s.objectives[name]
+}
+
+// DisplayedObjective returns the objective shown in a display slot, or nil.
+func (s *Scoreboard) DisplayedObjective(slot int32) *Objective {
+	return s.objectives[s.displays[slot]]
+}
+
+// Score returns the score of an owner for an objective, or nil if unset.
+func (s *Scoreboard) Score(owner, objective string) *Score {
+	return s.scores[owner][objective]
+}
+
+// Scores returns the scores of an objective, sorted like the sidebar:
+// highest value first, then by owner (case-insensitive).
+func (s *Scoreboard) Scores(objective string) []*Score {
+	var scores []*Score
+	for _, owned := range s.scores {
+		if score := owned[objective]; score != nil {
+			scores = append(scores, score)
+		}
+	}
+	slices.SortFunc(scores, func(a, b *Score) int {
+		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+			return c
+		}
+		return cmp.Compare(strings.ToLower(a.Owner), strings.ToLower(b.Owner))
+	})
+	return scores
+}
+
+// Team returns the team with the given name, or nil if unknown.
+func (s *Scoreboard) Team(name string) *Team {
+	return s.teams[name]
+}
+
+// PlayerTeam returns the team of a player (or entity UUID), or nil.
+func (s *Scoreboard) PlayerTeam(player string) *Team {
+	return s.playerTeams[player]
+}
+
+// Teams returns all teams, sorted by name.
+func (s *Scoreboard) Teams() []*Team {
+	teams := make([]*Team, 0, len(s.teams))
+	for _, t := range s.teams {
+		teams = append(teams, t)
+	}
+	slices.SortFunc(teams, func(a, b *Team) int { return strings.Compare(a.Name, b.Name) })
+	return teams
+}
+
+// SidebarLine is a rendered sidebar row.
+type SidebarLine struct {
+	Owner string
+	Value int32
+	// Name is the owner (or score display name), decorated with the owner's team.
+	Name ns.TextComponent
+	// Score is the formatted score, shown right-aligned; empty for the blank number format.
+	Score ns.TextComponent
+}
+
+// String returns the line as plain text.
+func (l SidebarLine) String() string {
+	name, score := l.Name.String(), l.Score.String()
+	if score == "" {
+		return name
+	}
+	return name + " " + score
+}
+
+// Sidebar is the rendered sidebar.
+type Sidebar struct {
+	Objective string
+	Title     ns.TextComponent
+	Lines     []SidebarLine
+}
+
+// Strings returns the title followed by the lines, as plain text.
+func (sb *Sidebar) Strings() []string {
+	lines := make([]string, 0, len(sb.Lines)+1)
+	lines = append(lines, sb.Title.String())
+	for _, l := range sb.Lines {
+		lines = append(lines, l.String())
+	}
+	return lines
+}
+
+// Sidebar renders the sidebar as seen by the given player, or returns nil if none is shown.
+// Like the client, the team sidebar of the player's team color takes precedence,
+// hidden scores are skipped and at most SidebarMaxLines scores are shown.
+func (s *Scoreboard) Sidebar(player string) *Sidebar {
+	var objective *Objective
+	if t := s.playerTeams[player]; t != nil && t.ColorName() != "" {
+		objective = s.DisplayedObjective(int32(packets.DisplaySlotTeamSidebar) + t.Color)
+	}
+	if objective == nil {
+		objective = s.DisplayedObjective(int32(packets.DisplaySlotSidebar))
+	}
+	if objective == nil {
+		return nil
+	}
+
+	sb := &Sidebar{Objective: objective.Name, Title: objective.DisplayName}
+	for _, score := range s.Scores(objective.Name) {
+		if score.Hidden() {
+			continue
+		}
+		if len(sb.Lines) == SidebarMaxLines {
+			break
+		}
+		name := ns.TextComponent{Text: score.Owner}
+		if score.DisplayName != nil {
+			name = *score.DisplayName
+		}
+		if t := s.playerTeams[score.Owner]; t != nil {
+			name = t.FormatName(name)
+		}
+		format := score.NumberFormat
+		if format == nil {
+			format = objective.NumberFormat
+		}
+		sb.Lines = append(sb.Lines, SidebarLine{
+			Owner: score.Owner,
+			Value: score.Value,
+			Name:  name,
+			Score: FormatScore(score.Value, format),
+		})
+	}
+	return sb
+}
+
+// FormatScore formats a score value with a number format.
+// A nil format uses the sidebar default (red).
+func FormatScore(value int32, format *packets.NumberFormat) ns.TextComponent {
+	if format == nil {
+		return ns.TextComponent{Text: strconv.Itoa(int(value)), Color: "red"}
+	}
+	switch format.Type {
+	case packets.NumberFormatBlank:
+		return ns.TextComponent{}
+	case packets.NumberFormatFixed:
+		return format.Content
+	}
+	style := format.Style
+	return ns.TextComponent{
+		Text:          strconv.Itoa(int(value)),
+		Color:         style.Color,
+		Bold:          style.Bold,
+		Italic:        style.Italic,
+		Underlined:    style.Underlined,
+		Strikethrough: style.Strikethrough,
+		Obfuscated:    style.Obfuscated,
+		Font:          style.Font,
+	}
+}
@@ -0,0 +1,128 @@
+package scoreboard_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/scoreboard"
+	"github.com/go-mclib/data/pkg/data/text"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setScore(sb *scoreboard.Scoreboard, owner, objective string, value int32) {
+	sb.ApplyScore(&packets.S2CSetScore{
+		EntityName:    ns.String(owner),
+		ObjectiveName: ns.String(objective),
+		Value:         ns.VarInt(value),
+	})
+}
+
+func TestScoreboard(t *testing.T) {
+	sb := scoreboard.NewScoreboard()
+	sb.ApplyObjective(&packets.S2CSetObjective{
+		ObjectiveName: "kills",
+		Mode:          packets.ObjectiveModeCreate,
+		DisplayName:   ns.TextComponent{Text: "Kills"},
+	})
+	sb.ApplyDisplayObjective(&packets.S2CSetDisplayObjective{Position: packets.DisplaySlotSidebar, ScoreName: "kills"})
+	setScore(sb, "bob", "kills", 5)
+	setScore(sb, "Alice", "kills", 5)
+	setScore(sb, "Carol", "kills", 9)
+	setScore(sb, "#hidden", "kills", 100)
+	setScore(sb, "Dave", "unknown", 1)
+	assert.Nil(t, sb.Score("Dave", "unknown"))
+
+	sb.ApplyTeam(&packets.S2CSetPlayerTeam{
+		TeamName: "red",
+		Method:   packets.TeamMethodCreate,
+		Color:    12,
+		Prefix:   ns.TextComponent{Text: "[R] "},
+		Players:  ns.PrefixedArray[ns.String]{"Alice"},
+	})
+	require.NotNil(t, sb.PlayerTeam("Alice"))
+	assert.Equal(t, "red", sb.PlayerTeam("Alice").ColorName())
+
+	sidebar := sb.Sidebar("Alice")
+	require.NotNil(t, sidebar)
+	assert.Equal(t, []string{"Kills", "Carol 9", "[R] Alice 5", "bob 5"}, sidebar.Strings())
+	assert.Equal(t, "red", sidebar.Lines[1].Name.Color)
+	assert.Equal(t, "red", sidebar.Lines[0].Score.Color)
+
+	// a team sidebar takes precedence for members of that team
+	sb.ApplyObjective(&packets.S2CSetObjective{
+		ObjectiveName: "team",
+		Mode:          packets.ObjectiveModeCreate,
+		DisplayName:   ns.TextComponent{Text: "Team"},
+		NumberFormat:  ns.Some(packets.NumberFormat{Type: packets.NumberFormatBlank}),
+	})
+	sb.ApplyDisplayObjective(&packets.S2CSetDisplayObjective{Position: packets.DisplaySlotTeamSidebar + 12, ScoreName: "team"})
+	setScore(sb, "Alice", "team", 1)
+	assert.Equal(t, []string{"Team", "[R] Alice"}, sb.Sidebar("Alice").Strings())
+	assert.Equal(t, "Kills", sb.Sidebar("bob").Title.Text)
+
+	// joining another team leaves the previous one
+	sb.ApplyTeam(&packets.S2CSetPlayerTeam{TeamName: "blue", Method: packets.TeamMethodCreate, Color: 9, Players: ns.PrefixedArray[ns.String]{"Alice"}})
+	assert.Empty(t, sb.Team("red").Players())
+	assert.Equal(t, []string{"Alice"}, sb.Team("blue").Players())
+	assert.Equal(t, "Kills", sb.Sidebar("Alice").Title.Text)
+
+	// resetting all scores of an owner
+	sb.ApplyResetScore(&packets.S2CResetScore{EntityName: "Carol"})
+	assert.Nil(t, sb.Score("Carol", "kills"))
+	sb.ApplyResetScore(&packets.S2CResetScore{EntityName: "bob", ObjectiveName: ns.Some[ns.String]("kills")})
+	assert.Len(t, sb.Scores("kills"), 2)
+
+	// removing an objective clears its scores and display slot
+	sb.ApplyObjective(&packets.S2CSetObjective{ObjectiveName: "kills", Mode: packets.ObjectiveModeRemove})
+	assert.Nil(t, sb.Score("Alice", "kills"))
+	assert.Nil(t, sb.DisplayedObjective(int32(packets.DisplaySlotSidebar)))
+	assert.Nil(t, sb.Sidebar("bob"))
+
+	sb.ApplyTeam(&packets.S2CSetPlayerTeam{TeamName: "blue", Method: packets.TeamMethodRemove})
+	assert.Nil(t, sb.PlayerTeam("Alice"))
+	assert.Len(t, sb.Teams(), 1)
+}
+
+func TestTeamFormatName(t *testing.T) {
+	team := &scoreboard.Team{
+		Color:  12,
+		Prefix: ns.TextComponent{Text: "[R] "},
+		Suffix: ns.TextComponent{Text: " *", Color: "gold"},
+	}
+	// the prefix inherits the team color like the name, the suffix keeps its own
+	assert.Equal(t, []text.Span{
+		{Text: "[R] Alice", Style: text.Style{Color: "red"}},
+		{Text: " *", Style: text.Style{Color: "gold"}},
+	}, text.Flatten(team.FormatName(ns.TextComponent{Text: "Alice"})))
+
+	// reset (21) is not a color
+	team = &scoreboard.Team{Color: 21, Prefix: ns.TextComponent{Text: "[R] "}}
+	assert.Equal(t, []text.Span{{Text: "[R] Alice"}}, text.Flatten(team.FormatName(ns.TextComponent{Text: "Alice"})))
+}
+
+func TestSidebarLimit(t *testing.T) {
+	sb := scoreboard.NewScoreboard()
+	sb.ApplyObjective(&packets.S2CSetObjective{ObjectiveName: "o", Mode: packets.ObjectiveModeCreate})
+	sb.ApplyDisplayObjective(&packets.S2CSetDisplayObjective{Position: packets.DisplaySlotSidebar, ScoreName: "o"})
+	for i := range 20 {
+		setScore(sb, string(rune('a'+i)), "o", int32(i))
+	}
+	sidebar := sb.Sidebar("")
+	require.Len(t, sidebar.Lines, scoreboard.SidebarMaxLines)
+	assert.Equal(t, "t", sidebar.Lines[0].Owner)
+}
+
+func TestFormatScore(t *testing.T) {
+	bold := true
+	styled := &packets.NumberFormat{Type: packets.NumberFormatStyled, Style: ns.TextComponent{Color: "gold", Bold: &bold}}
+	got := scoreboard.FormatScore(42, styled)
+	assert.Equal(t, "42", got.Text)
+	assert.Equal(t, "gold", got.Color)
+	assert.True(t, *got.Bold)
+
+	fixed := &packets.NumberFormat{Type: packets.NumberFormatFixed, Content: ns.TextComponent{Text: "MVP"}}
+	assert.Equal(t, "MVP", scoreboard.FormatScore(1, fixed).String())
+	assert.Empty(t, scoreboard.FormatScore(1, &packets.NumberFormat{Type: packets.NumberFormatBlank}).String())
+}
@@ -0,0 +1,75 @@
+// Package sounds provides sound event metadata and resolves the sound events of
+// S2CSound, S2CSoundEntity and S2CExplode packets.
+package sounds
+
+import (
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// DefaultRange is the audible range of variable range sounds at volume 1 or below.
+const DefaultRange = 16
+
+// Sound is a sound event.
+type Sound struct {
+	// ID is the minecraft:sound_event protocol ID, or -1 for inline sound events.
+	ID   int32
+	Name string
+	// Source is the sound source (packets.SoundSource*) the sound is usually played in,
+	// derived from its name. Packets carry the actual source.
+	Source ns.VarInt
+	// FixedRange is the audible range in blocks, or 0 if it depends on the volume.
+	FixedRange float32
+}
+
+// Range returns the distance in blocks at which the sound can be heard at the given volume.
+func (s *Sound) Range(volume float32) float32 {
+	if s.FixedRange > 0 {
+		return s.FixedRange
+	}
+	return DefaultRange * max(volume, 1)
+}
+
+var soundsByName = func() map[string]*Sound {
+	m := make(map[string]*Sound, len(soundEvents))
+	for i := range soundEvents {
+		m[soundEvents[i].Name] = &soundEvents[i]
+	}
+	return m
+}()
+
+// ByID returns the sound event with the given protocol ID, or nil if unknown.
+func ByID(id int32) *Sound {
+	if id < 0 || int(id) >= len(soundEvents) {
+		return nil
+	}
+	return &soundEvents[id]
+}
+
+// ByName returns the sound event with the given identifier, or nil if unknown.
+func ByName(name string) *Sound {
+	return soundsByName[name]
+}
+
+// Len returns the number of sound events.
+func Len() int {
+	return len(soundEvents)
+}
+
+// Resolve returns the sound event of a holder, or nil for unknown registry IDs.
+// Inline sound events take their fixed range from the packet and their source
+// from the registered sound of the same name, if any.
+func Resolve(h packets.SoundEventHolder) *Sound {
+	if !h.IsInline {
+		return ByID(int32(h.ID))
+	}
+	s := Sound{ID: -1, Name: string(h.Value.SoundId), Source: packets.SoundSourceMaster}
+	if registered := ByName(s.Name); registered != nil {
+		s.Source = registered.Source
+	}
+	if r, ok := h.Value.FixedRange.Get(); ok {
+		s.FixedRange = float32(r)
+	}
+	return &s
+}
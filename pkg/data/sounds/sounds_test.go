@@ -0,0 +1,38 @@
+package sounds_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/sounds"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSounds(t *testing.T) {
+	assert.Greater(t, sounds.Len(), 1000)
+
+	primed := sounds.ByName("minecraft:entity.creeper.primed")
+	require.NotNil(t, primed)
+	assert.Equal(t, primed, sounds.ByID(primed.ID))
+	assert.Equal(t, packets.SoundSourceHostile, primed.Source)
+	assert.Equal(t, float32(sounds.DefaultRange), primed.Range(0.5))
+	assert.Equal(t, float32(64), primed.Range(4))
+
+	assert.Equal(t, packets.SoundSourceMusic, sounds.ByName("minecraft:music.menu").Source)
+	assert.Equal(t, packets.SoundSourcePlayers, sounds.ByName("minecraft:entity.player.hurt").Source)
+	assert.Nil(t, sounds.ByName("minecraft:missing"))
+	assert.Nil(t, sounds.ByID(-1))
+
+	assert.Equal(t, primed, sounds.Resolve(packets.NewSoundEventHolder("minecraft:entity.creeper.primed")))
+
+	inline := sounds.Resolve(packets.SoundEventHolder{IDOrX: ns.NewInlineValue(packets.SoundEvent{
+		SoundId:    "minecraft:entity.creeper.primed",
+		FixedRange: ns.Some[ns.Float32](32),
+	})})
+	require.NotNil(t, inline)
+	assert.Equal(t, int32(-1), inline.ID)
+	assert.Equal(t, packets.SoundSourceHostile, inline.Source)
+	assert.Equal(t, float32(32), inline.Range(4))
+}
@@ -0,0 +1,282 @@
+// Package stats keeps player statistics from S2CAwardStats packets and reads and
+// writes the world/stats/<uuid>.json files of the server.
+package stats
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/data/storage"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// Stat types of the minecraft:stat_type registry.
+const (
+	Mined    = "minecraft:mined"
+	Crafted  = "minecraft:crafted"
+	Used     = "minecraft:used"
+	Broken   = "minecraft:broken"
+	PickedUp = "minecraft:picked_up"
+	Dropped  = "minecraft:dropped"
+	Killed   = "minecraft:killed"
+	KilledBy = "minecraft:killed_by"
+	Custom   = "minecraft:custom"
+)
+
+// TargetRegistry returns the registry of the targets of a stat type, or nil if
+// the stat type is unknown.
+func TargetRegistry(statType string) *registries.Registry {
+	switch statType {
+	case Mined:
+		return registries.Block
+	case Crafted, Used, Broken, PickedUp, Dropped:
+		return registries.Item
+	case Killed, KilledBy:
+		return registries.EntityType
+	case Custom:
+		return registries.CustomStat
+	}
+	return nil
+}
+
+// Stat is a statistic: a stat type and its target, e.g. the stone blocks mined.
+type Stat struct {
+	// Type is the minecraft:stat_type entry, e.g. "minecraft:mined".
+	Type string
+	// Target is the entry in the stat type's registry, e.g. "minecraft:stone".
+	Target string
+}
+
+// StatFromIDs resolves the protocol IDs of a stat type and its target.
+func StatFromIDs(statType, target int32) (Stat, error) {
+	typeName := registries.StatType.ByID(statType)
+	reg := TargetRegistry(typeName)
+	if reg == nil {
+		return Stat{}, fmt.Errorf("unknown stat type %d", statType)
+	}
+	targetName := reg.ByID(target)
+	if targetName == "" {
+		return Stat{}, fmt.Errorf("unknown %s stat target %d", typeName, target)
+	}
+	return Stat{Type: typeName, Target: targetName}, nil
+}
+
+// IDs returns the protocol IDs of the stat type and target.
+func (s Stat) IDs() (statType, target int32, err error) {
+	reg := TargetRegistry(s.Type)
+	if reg == nil {
+		return 0, 0, fmt.Errorf("unknown stat type %q", s.Type)
+	}
+	target = reg.Get(s.Target)
+	if target < 0 {
+		return 0, 0, fmt.Errorf("unknown %s stat target %q", s.Type, s.Target)
+	}
+	return registries.StatType.Get(s.Type), target, nil
+}
+
+// String returns the stat in the vanilla "type:target" form with namespaces
+// shortened, e.g. "minecraft.mined:minecraft.stone".
+func (s Stat) String() string {
+	return strings.Replace(s.Type, ":", ".", 1) + ":" + strings.Replace(s.Target, ":", ".", 1)
+}
+
+// Statistics holds the values of a player's statistics.
+type Statistics struct {
+	values map[Stat]int32
+}
+
+// NewStatistics returns empty statistics.
+func NewStatistics() *Statistics {
+	return &Statistics{values: make(map[Stat]int32)}
+}
+
+// ApplyAwardStats sets the awarded values. The values are totals, not increments.
+func (s *Statistics) ApplyAwardStats(p *packets.S2CAwardStats) error {
+	for _, v := range p.Statistics {
+		stat, err := StatFromIDs(int32(v.StatType), int32(v.StatId))
+		if err != nil {
+			return err
+		}
+		s.values[stat] = int32(v.Value)
+	}
+	return nil
+}
+
+// AwardStats returns an S2CAwardStats packet with all values, sorted by stat.
+func (s *Statistics) AwardStats() (*packets.S2CAwardStats, error) {
+	p := &packets.S2CAwardStats{Statistics: make([]packets.Statistic, 0, len(s.values))}
+	for _, stat := range s.Stats() {
+		statType, target, err := stat.IDs()
+		if err != nil {
+			return nil, err
+		}
+		p.Statistics = append(p.Statistics, packets.Statistic{
+			StatType: ns.VarInt(statType),
+			StatId:   ns.VarInt(target),
+			Value:    ns.VarInt(s.values[stat]),
+		})
+	}
+	return p, nil
+}
+
+// Get returns the value of a stat, or 0 if it was never awarded.
+func (s *Statistics) Get(stat Stat) int32 {
+	return s.values[stat]
+}
+
+// Set sets the value of a stat.
+func (s *Statistics) Set(stat Stat, value int32) {
+	s.values[stat] = value
+}
+
+// Len returns the number of stats with a value.
+func (s *Statistics) Len() int {
+	return len(s.values)
+}
+
+// Stats returns the stats with a value, sorted by type and target.
+func (s *Statistics) Stats() []Stat {
+	return slices.SortedFunc(maps.Keys(s.values), func(a, b Stat) int {
+		return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.Target, b.Target))
+	})
+}
+
+// Mined returns the number of times a block was mined.
+func (s *Statistics) Mined(blockID int32) int32 {
+	return s.get(Mined, registries.Block, blockID)
+}
+
+// Crafted returns the number of items crafted.
+func (s *Statistics) Crafted(itemID int32) int32 {
+	return s.get(Crafted, registries.Item, itemID)
+}
+
+// Used returns the number of times an item was used.
+func (s *Statistics) Used(itemID int32) int32 {
+	return s.get(Used, registries.Item, itemID)
+}
+
+// Broken returns the number of times an item broke.
+func (s *Statistics) Broken(itemID int32) int32 {
+	return s.get(Broken, registries.Item, itemID)
+}
+
+// PickedUp returns the number of items picked up.
+func (s *Statistics) PickedUp(itemID int32) int32 {
+	return s.get(PickedUp, registries.Item, itemID)
+}
+
+// Dropped returns the number of items dropped.
+func (s *Statistics) Dropped(itemID int32) int32 {
+	return s.get(Dropped, registries.Item, itemID)
+}
+
+// Killed returns the number of entities of a type the player killed.
+func (s *Statistics) Killed(entityTypeID int32) int32 {
+	return s.get(Killed, registries.EntityType, entityTypeID)
+}
+
+// KilledBy returns the number of times the player was killed by an entity type.
+func (s *Statistics) KilledBy(entityTypeID int32) int32 {
+	return s.get(KilledBy, registries.EntityType, entityTypeID)
+}
+
+// Custom returns the value of a custom stat, e.g. "minecraft:play_time" in ticks.
+func (s *Statistics) Custom(name string) int32 {
+	return s.values[Stat{Type: Custom, Target: name}]
+}
+
+func (s *Statistics) get(statType string, reg *registries.Registry, id int32) int32 {
+	return s.values[Stat{Type: statType, Target: reg.ByID(id)}]
+}
+
+// statsFile is the JSON format of world/stats/<uuid>.json.
+type statsFile struct {
+	Stats       map[string]map[string]int32 `json:"stats"`
+	DataVersion int                         `json:"DataVersion"`
+}
+
+// MarshalJSON encodes the statistics in the format of world/stats/<uuid>.json.
+func (s *Statistics) MarshalJSON() ([]byte, error) {
+	f := statsFile{Stats: make(map[string]map[string]int32), DataVersion: storage.DataVersion}
+	for stat, value := range s.values {
+		if f.Stats[stat.Type] == nil {
+			f.Stats[stat.Type] = make(map[string]int32)
+		}
+		f.Stats[stat.Type][stat.Target] = value
+	}
+	return json.Marshal(f)
+}
+
+// UnmarshalJSON decodes statistics in the format of world/stats/<uuid>.json,
+// replacing all values. Like the vanilla server, unknown stat types and targets
+// are skipped.
+func (s *Statistics) UnmarshalJSON(data []byte) error {
+	var f statsFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return err
+	}
+	s.values = make(map[Stat]int32)
+	for statType, targets := range f.Stats {
+		reg := TargetRegistry(statType)
+		if reg == nil {
+			continue
+		}
+		for target, value := range targets {
+			if reg.Get(target) < 0 {
+				continue
+			}
+			s.values[Stat{Type: statType, Target: target}] = value
+		}
+	}
+	return nil
+}
+
+// ReadJSON reads statistics in the format of world/stats/<uuid>.json.
+func ReadJSON(r io.Reader) (*Statistics, error) {
+	s := NewStatistics()
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// WriteJSON writes the statistics in the format of world/stats/<uuid>.json.
+func (s *Statistics) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(s)
+}
+
+// Load reads statistics from <dir>/<uuid>.json. Returns nil, nil if the file doesn't exist.
+func Load(dir, uuid string) (*Statistics, error) {
+	f, err := os.Open(filepath.Join(dir, uuid+".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	return ReadJSON(f)
+}
+
+// Save writes the statistics to <dir>/<uuid>.json.
+func (s *Statistics) Save(dir, uuid string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, uuid+".json"), data, 0644)
+}
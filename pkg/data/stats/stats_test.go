@@ -0,0 +1,81 @@
+package stats_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/blocks"
+	"github.com/go-mclib/data/pkg/data/entities"
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/data/stats"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statType(name string) ns.VarInt {
+	return ns.VarInt(registries.StatType.Get(name))
+}
+
+func TestApplyAwardStats(t *testing.T) {
+	p := &packets.S2CAwardStats{Statistics: []packets.Statistic{
+		{StatType: statType(stats.Mined), StatId: ns.VarInt(blocks.BlockID("minecraft:stone")), Value: 64},
+		{StatType: statType(stats.Used), StatId: ns.VarInt(items.ItemID("minecraft:diamond_pickaxe")), Value: 64},
+		{StatType: statType(stats.Killed), StatId: ns.VarInt(entities.EntityTypeID("minecraft:zombie")), Value: 3},
+		{StatType: statType(stats.Custom), StatId: ns.VarInt(registries.CustomStat.Get("minecraft:play_time")), Value: 72000},
+	}}
+	s := stats.NewStatistics()
+	require.NoError(t, s.ApplyAwardStats(p))
+	assert.Equal(t, int32(64), s.Mined(blocks.BlockID("minecraft:stone")))
+	assert.Equal(t, int32(0), s.Mined(blocks.BlockID("minecraft:dirt")))
+	assert.Equal(t, int32(64), s.Used(items.ItemID("minecraft:diamond_pickaxe")))
+	assert.Equal(t, int32(3), s.Killed(entities.EntityTypeID("minecraft:zombie")))
+	assert.Equal(t, int32(72000), s.Custom("minecraft:play_time"))
+
+	// values are totals
+	require.NoError(t, s.ApplyAwardStats(&packets.S2CAwardStats{Statistics: []packets.Statistic{
+		{StatType: statType(stats.Killed), StatId: ns.VarInt(entities.EntityTypeID("minecraft:zombie")), Value: 4},
+	}}))
+	assert.Equal(t, int32(4), s.Killed(entities.EntityTypeID("minecraft:zombie")))
+	assert.Equal(t, 4, s.Len())
+
+	again, err := s.AwardStats()
+	require.NoError(t, err)
+	assert.Len(t, again.Statistics, 4)
+	assert.Equal(t, statType(stats.Custom), again.Statistics[0].StatType, "sorted by stat type")
+
+	assert.Error(t, s.ApplyAwardStats(&packets.S2CAwardStats{Statistics: []packets.Statistic{{StatType: 99}}}))
+	assert.Error(t, s.ApplyAwardStats(&packets.S2CAwardStats{Statistics: []packets.Statistic{{StatType: statType(stats.Mined), StatId: 1 << 20}}}))
+}
+
+func TestStatsFile(t *testing.T) {
+	const file = `{"stats":{"minecraft:mined":{"minecraft:stone":12,"minecraft:not_a_block":1},` +
+		`"minecraft:custom":{"minecraft:jump":40},"mymod:unknown":{"mymod:x":1}},"DataVersion":4189}`
+	s, err := stats.ReadJSON(bytes.NewReader([]byte(file)))
+	require.NoError(t, err)
+	assert.Equal(t, 2, s.Len(), "unknown types and targets are skipped")
+	assert.Equal(t, int32(12), s.Get(stats.Stat{Type: stats.Mined, Target: "minecraft:stone"}))
+	assert.Equal(t, int32(40), s.Custom("minecraft:jump"))
+
+	dir := t.TempDir()
+	require.NoError(t, s.Save(dir, "069a79f4-44e9-4726-a5be-fca90e38aaf5"))
+	loaded, err := stats.Load(dir, "069a79f4-44e9-4726-a5be-fca90e38aaf5")
+	require.NoError(t, err)
+	assert.Equal(t, s.Stats(), loaded.Stats())
+
+	var raw map[string]any
+	var out bytes.Buffer
+	require.NoError(t, loaded.WriteJSON(&out))
+	require.NoError(t, json.Unmarshal(out.Bytes(), &raw))
+	assert.Equal(t, map[string]any{"minecraft:stone": 12.0}, raw["stats"].(map[string]any)["minecraft:mined"])
+	assert.Contains(t, raw, "DataVersion")
+
+	missing, err := stats.Load(dir, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, missing)
+
+	assert.Equal(t, "minecraft.mined:minecraft.stone", stats.Stat{Type: stats.Mined, Target: "minecraft:stone"}.String())
+}
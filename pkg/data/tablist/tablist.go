@@ -0,0 +1,167 @@
+package tablist
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// game mode IDs, as sent in S2CPlayerInfoUpdate
+const (
+	GameModeSurvival int32 = iota
+	GameModeCreative
+	GameModeAdventure
+	GameModeSpectator
+)
+
+// Player is a single player info entry.
+type Player struct {
+	UUID        ns.UUID
+	Name        string
+	Properties  []ns.ProfileProperty
+	ChatSession *packets.RemoteChatSession
+	GameMode    int32
+	Listed      bool
+	// Latency is the ping in milliseconds.
+	Latency     int32
+	DisplayName *ns.TextComponent
+	ListOrder   int32
+	ShowHat     bool
+}
+
+// TabList tracks the player list from S2CPlayerInfoUpdate, S2CPlayerInfoRemove
+// and S2CTabList packets.
+type TabList struct {
+	Header  ns.TextComponent
+	Footer  ns.TextComponent
+	players map[ns.UUID]*Player
+}
+
+// NewTabList creates an empty TabList.
+func NewTabList() *TabList {
+	return &TabList{players: make(map[ns.UUID]*Player)}
+}
+
+// ApplyInfoUpdate applies the actions of a player info update.
+// Entries for unknown players are ignored unless the packet adds them; adding a
+// player that is already listed keeps the existing entry, like vanilla.
+func (t *TabList) ApplyInfoUpdate(p *packets.S2CPlayerInfoUpdate) {
+	for i := range p.Entries {
+		e := &p.Entries[i]
+		player := t.players[e.Uuid]
+		if player == nil && p.Actions.Has(packets.PlayerInfoAddPlayer) {
+			player = &Player{
+				UUID:       e.Uuid,
+				Name:       string(e.Name),
+				Properties: slices.Clone(e.Properties),
+			}
+			t.players[e.Uuid] = player
+		}
+		if player == nil {
+			continue
+		}
+		if p.Actions.Has(packets.PlayerInfoInitializeChat) {
+			player.ChatSession = nil
+			if e.ChatSession.Present {
+				session := e.ChatSession.Value
+				player.ChatSession = &session
+			}
+		}
+		if p.Actions.Has(packets.PlayerInfoUpdateGameMode) {
+			player.GameMode = int32(e.GameMode)
+		}
+		if p.Actions.Has(packets.PlayerInfoUpdateListed) {
+			player.Listed = bool(e.Listed)
+		}
+		if p.Actions.Has(packets.PlayerInfoUpdateLatency) {
+			player.Latency = int32(e.Latency)
+		}
+		if p.Actions.Has(packets.PlayerInfoUpdateDisplayName) {
+			player.DisplayName = nil
+			if e.DisplayName.Present {
+				name := e.DisplayName.Value
+				player.DisplayName = &name
+			}
+		}
+		if p.Actions.Has(packets.PlayerInfoUpdateListOrder) {
+			player.ListOrder = int32(e.ListOrder)
+		}
+		if p.Actions.Has(packets.PlayerInfoUpdateHat) {
+			player.ShowHat = bool(e.ShowHat)
+		}
+	}
+}
+
+// ApplyInfoRemove removes players from the list.
+func (t *TabList) ApplyInfoRemove(p *packets.S2CPlayerInfoRemove) {
+	for _, id := range p.Uuids {
+		delete(t.players, id)
+	}
+}
+
+// ApplyHeaderFooter sets the tab list header and footer.
+func (t *TabList) ApplyHeaderFooter(p *packets.S2CTabList) {
+	t.Header = p.Header
+	t.Footer = p.Footer
+}
+
+// Player returns the player with the given UUID, or nil if unknown.
+func (t *TabList) Player(id ns.UUID) *Player {
+	return t.players[id]
+}
+
+// PlayerByName returns the player with the given name (case-insensitive), or nil if unknown.
+func (t *TabList) PlayerByName(name string) *Player {
+	for _, p := range t.players {
+		if strings.EqualFold(p.Name, name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Len returns the number of known players, listed or not.
+func (t *TabList) Len() int {
+	return len(t.players)
+}
+
+// Players returns all known players, including unlisted ones, sorted by name.
+func (t *TabList) Players() []*Player {
+	players := make([]*Player, 0, len(t.players))
+	for _, p := range t.players {
+		players = append(players, p)
+	}
+	slices.SortFunc(players, func(a, b *Player) int {
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return players
+}
+
+// Listed returns the players shown in the tab list, in vanilla display order:
+// higher list order first, spectators last, then by name (case-insensitive).
+func (t *TabList) Listed() []*Player {
+	var players []*Player
+	for _, p := range t.players {
+		if p.Listed {
+			players = append(players, p)
+		}
+	}
+	slices.SortFunc(players, func(a, b *Player) int {
+		if c := cmp.Compare(b.ListOrder, a.ListOrder); c != 0 {
+			return c
+		}
+		aSpec, bSpec := a.GameMode == GameModeSpectator, b.GameMode == GameModeSpectator
+		if aSpec != bSpec {
+			if aSpec {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return players
+}
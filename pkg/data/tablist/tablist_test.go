@@ -0,0 +1,102 @@
+package tablist_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/tablist"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice, _ = ns.UUIDFromString("f8ccd41b-3ab8-32d1-a575-afb9913101d6")
+	bob, _   = ns.UUIDFromString("8135db86-12a7-4d3a-8bc4-7e1456c21390")
+)
+
+func TestTabList(t *testing.T) {
+	tl := tablist.NewTabList()
+
+	add := &packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoAddPlayer | packets.PlayerInfoInitializeChat | packets.PlayerInfoUpdateGameMode |
+			packets.PlayerInfoUpdateListed | packets.PlayerInfoUpdateLatency | packets.PlayerInfoUpdateDisplayName |
+			packets.PlayerInfoUpdateListOrder | packets.PlayerInfoUpdateHat,
+		Entries: []packets.PlayerInfoEntry{
+			{
+				Uuid: alice,
+				Name: "Alice",
+				Properties: ns.PrefixedArray[ns.ProfileProperty]{
+					{Name: "textures", Value: "e30=", Signature: ns.Some[ns.String]("sig")},
+				},
+				ChatSession: ns.Some(packets.RemoteChatSession{SessionId: bob, ExpiresAt: 1234, PublicKey: []byte{1, 2}, KeySignature: []byte{3}}),
+				GameMode:    ns.VarInt(tablist.GameModeCreative),
+				Listed:      true,
+				Latency:     42,
+				DisplayName: ns.Some(ns.TextComponent{Text: "[Admin] Alice"}),
+				ShowHat:     true,
+			},
+			{
+				Uuid:     bob,
+				Name:     "bob",
+				GameMode: ns.VarInt(tablist.GameModeSpectator),
+				Listed:   true,
+				Latency:  7,
+			},
+		},
+	}
+	tl.ApplyInfoUpdate(add)
+	require.Equal(t, 2, tl.Len())
+
+	a := tl.Player(alice)
+	require.NotNil(t, a)
+	assert.Equal(t, "Alice", a.Name)
+	assert.Equal(t, tablist.GameModeCreative, a.GameMode)
+	assert.Equal(t, int32(42), a.Latency)
+	assert.True(t, a.ShowHat)
+	require.NotNil(t, a.ChatSession)
+	assert.Equal(t, bob, a.ChatSession.SessionId)
+	require.NotNil(t, a.DisplayName)
+	assert.Equal(t, "[Admin] Alice", a.DisplayName.Text)
+	require.Len(t, a.Properties, 1)
+	assert.Equal(t, ns.String("textures"), a.Properties[0].Name)
+
+	// spectators are listed last
+	listed := tl.Listed()
+	require.Len(t, listed, 2)
+	assert.Equal(t, "Alice", listed[0].Name)
+	assert.Equal(t, "bob", listed[1].Name)
+
+	// partial update only touches the given fields
+	tl.ApplyInfoUpdate(&packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoUpdateLatency | packets.PlayerInfoUpdateListOrder,
+		Entries: []packets.PlayerInfoEntry{{Uuid: bob, Latency: 99, ListOrder: 10}},
+	})
+	b := tl.PlayerByName("BOB")
+	require.NotNil(t, b)
+	assert.Equal(t, int32(99), b.Latency)
+	assert.Equal(t, tablist.GameModeSpectator, b.GameMode)
+	assert.Equal(t, "bob", tl.Listed()[0].Name)
+
+	// adding a known player again keeps the existing entry
+	tl.ApplyInfoUpdate(&packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoAddPlayer,
+		Entries: []packets.PlayerInfoEntry{{Uuid: alice, Name: "Alice2"}},
+	})
+	assert.Same(t, a, tl.Player(alice))
+	assert.Equal(t, "Alice", a.Name)
+	assert.Equal(t, tablist.GameModeCreative, a.GameMode)
+	assert.True(t, a.Listed)
+	require.NotNil(t, a.DisplayName)
+
+	tl.ApplyInfoRemove(&packets.S2CPlayerInfoRemove{Uuids: []ns.UUID{alice}})
+	assert.Nil(t, tl.Player(alice))
+	assert.Equal(t, 1, tl.Len())
+
+	tl.ApplyHeaderFooter(&packets.S2CTabList{
+		Header: ns.TextComponent{Text: "Welcome"},
+		Footer: ns.TextComponent{Text: "example.org"},
+	})
+	assert.Equal(t, "Welcome", tl.Header.Text)
+	assert.Equal(t, "example.org", tl.Footer.Text)
+}
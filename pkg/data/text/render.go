@@ -0,0 +1,219 @@
+package text
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+// namedColor is one of the 16 named chat colors.
+type namedColor struct {
+	rgb    string
+	legacy byte
+	ansi   int
+}
+
+var namedColors = map[string]namedColor{
+	"black":        {"#000000", '0', 30},
+	"dark_blue":    {"#0000AA", '1', 34},
+	"dark_green":   {"#00AA00", '2', 32},
+	"dark_aqua":    {"#00AAAA", '3', 36},
+	"dark_red":     {"#AA0000", '4', 31},
+	"dark_purple":  {"#AA00AA", '5', 35},
+	"gold":         {"#FFAA00", '6', 33},
+	"gray":         {"#AAAAAA", '7', 37},
+	"dark_gray":    {"#555555", '8', 90},
+	"blue":         {"#5555FF", '9', 94},
+	"green":        {"#55FF55", 'a', 92},
+	"aqua":         {"#55FFFF", 'b', 96},
+	"red":          {"#FF5555", 'c', 91},
+	"light_purple": {"#FF55FF", 'd', 95},
+	"yellow":       {"#FFFF55", 'e', 93},
+	"white":        {"#FFFFFF", 'f', 97},
+}
+
+// hexColor parses a "#RRGGBB" color.
+func hexColor(color string) (r, g, b uint8, ok bool) {
+	if len(color) != 7 || color[0] != '#' {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(color[1:], 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
+
+// Plain returns the text of a component without formatting.
+func Plain(tc ns.TextComponent) string {
+	return defaultRenderer.Plain(tc)
+}
+
+// Plain returns the text of a component without formatting.
+func (r *Renderer) Plain(tc ns.TextComponent) string {
+	var b strings.Builder
+	for _, s := range r.Flatten(tc) {
+		b.WriteString(s.Text)
+	}
+	return b.String()
+}
+
+// ANSI returns the text of a component with ANSI escape codes for terminals.
+func ANSI(tc ns.TextComponent) string {
+	return defaultRenderer.ANSI(tc)
+}
+
+// ANSI returns the text of a component with ANSI escape codes for terminals.
+// Named colors use the 16 terminal colors and hex colors use 24-bit color.
+func (r *Renderer) ANSI(tc ns.TextComponent) string {
+	var b strings.Builder
+	prev := ""
+	for _, s := range r.Flatten(tc) {
+		if codes := ansiCodes(s.Style); codes != prev {
+			b.WriteString("\x1b[0" + codes + "m")
+			prev = codes
+		}
+		b.WriteString(s.Text)
+	}
+	if prev != "" {
+		b.WriteString("\x1b[0m")
+	}
+	return b.String()
+}
+
+// ansiCodes returns the SGR parameters of a style, each prefixed with ';'.
+func ansiCodes(s Style) string {
+	var b strings.Builder
+	if c, ok := namedColors[s.Color]; ok {
+		fmt.Fprintf(&b, ";%d", c.ansi)
+	} else if red, green, blue, ok := hexColor(s.Color); ok {
+		fmt.Fprintf(&b, ";38;2;%d;%d;%d", red, green, blue)
+	}
+	for _, f := range []struct {
+		on   bool
+		code string
+	}{{s.Bold, ";1"}, {s.Italic, ";3"}, {s.Underlined, ";4"}, {s.Strikethrough, ";9"}} {
+		if f.on {
+			b.WriteString(f.code)
+		}
+	}
+	return b.String()
+}
+
+// Legacy returns the text of a component with legacy § formatting codes. Hex
+// colors use the §x§R§R§G§G§B§B form understood by Bukkit-based servers.
+func Legacy(tc ns.TextComponent) string {
+	return defaultRenderer.Legacy(tc)
+}
+
+// Legacy returns the text of a component with legacy § formatting codes. Hex
+// colors use the §x§R§R§G§G§B§B form understood by Bukkit-based servers.
+func (r *Renderer) Legacy(tc ns.TextComponent) string {
+	var b strings.Builder
+	prev := ""
+	for _, s := range r.Flatten(tc) {
+		if codes := legacyCodes(s.Style); codes != prev {
+			// color codes reset the formatting, otherwise reset explicitly
+			if prev != "" && !hasLegacyColor(s.Style) {
+				b.WriteString("§r")
+			}
+			b.WriteString(codes)
+			prev = codes
+		}
+		b.WriteString(s.Text)
+	}
+	return b.String()
+}
+
+func hasLegacyColor(s Style) bool {
+	_, named := namedColors[s.Color]
+	_, _, _, hex := hexColor(s.Color)
+	return named || hex
+}
+
+// legacyCodes returns the color code of a style, then its formatting codes.
+func legacyCodes(s Style) string {
+	var b strings.Builder
+	if c, ok := namedColors[s.Color]; ok {
+		b.WriteString("§")
+		b.WriteByte(c.legacy)
+	} else if _, _, _, ok := hexColor(s.Color); ok {
+		b.WriteString("§x")
+		for _, c := range strings.ToLower(s.Color[1:]) {
+			b.WriteString("§")
+			b.WriteRune(c)
+		}
+	}
+	for _, f := range []struct {
+		on   bool
+		code string
+	}{{s.Obfuscated, "§k"}, {s.Bold, "§l"}, {s.Strikethrough, "§m"}, {s.Underlined, "§n"}, {s.Italic, "§o"}} {
+		if f.on {
+			b.WriteString(f.code)
+		}
+	}
+	return b.String()
+}
+
+// HTML returns the text of a component as HTML spans with inline styles.
+func HTML(tc ns.TextComponent) string {
+	return defaultRenderer.HTML(tc)
+}
+
+// HTML returns the text of a component as HTML spans with inline styles. Text
+// that opens an http or https URL when clicked is wrapped in a link.
+func (r *Renderer) HTML(tc ns.TextComponent) string {
+	var b strings.Builder
+	for _, s := range r.Flatten(tc) {
+		text := html.EscapeString(s.Text)
+		if css := htmlStyle(s.Style); css != "" {
+			text = `<span style="` + css + `">` + text + `</span>`
+		}
+		if e := s.Style.ClickEvent; e != nil && e.Action == "open_url" && safeURL(e.URL) {
+			text = `<a href="` + html.EscapeString(e.URL) + `">` + text + `</a>`
+		}
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+// safeURL reports whether a URL is an http or https link, the only kind the
+// vanilla client opens.
+func safeURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	return scheme == "http" || scheme == "https"
+}
+
+func htmlStyle(s Style) string {
+	var css []string
+	if c, ok := namedColors[s.Color]; ok {
+		css = append(css, "color:"+c.rgb)
+	} else if _, _, _, ok := hexColor(s.Color); ok {
+		css = append(css, "color:"+strings.ToUpper(s.Color))
+	}
+	if s.Bold {
+		css = append(css, "font-weight:bold")
+	}
+	if s.Italic {
+		css = append(css, "font-style:italic")
+	}
+	var decorations []string
+	if s.Underlined {
+		decorations = append(decorations, "underline")
+	}
+	if s.Strikethrough {
+		decorations = append(decorations, "line-through")
+	}
+	if len(decorations) > 0 {
+		css = append(css, "text-decoration:"+strings.Join(decorations, " "))
+	}
+	return strings.Join(css, ";")
+}
@@ -0,0 +1,209 @@
+// Package text renders text components as plain text, ANSI terminal text,
+// legacy § formatting codes or HTML, resolving translations like the vanilla
+// client.
+package text
+
+import (
+	"strings"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/data/lang"
+)
+
+// Style is the resolved style of a span of text.
+type Style struct {
+	// Color is a named color, such as "gold", or a "#RRGGBB" hex color, or empty.
+	Color         string
+	Bold          bool
+	Italic        bool
+	Underlined    bool
+	Strikethrough bool
+	Obfuscated    bool
+	Font          string
+	Insertion     string
+	ClickEvent    *ns.ClickEvent
+	HoverEvent    *ns.HoverEvent
+}
+
+// apply returns the style with the style fields set on tc overriding it.
+func (s Style) apply(tc *ns.TextComponent) Style {
+	if tc.Color != "" {
+		s.Color = tc.Color
+	}
+	set := func(dst *bool, v *bool) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	set(&s.Bold, tc.Bold)
+	set(&s.Italic, tc.Italic)
+	set(&s.Underlined, tc.Underlined)
+	set(&s.Strikethrough, tc.Strikethrough)
+	set(&s.Obfuscated, tc.Obfuscated)
+	if tc.Font != "" {
+		s.Font = tc.Font
+	}
+	if tc.Insertion != "" {
+		s.Insertion = tc.Insertion
+	}
+	if tc.ClickEvent != nil {
+		s.ClickEvent = tc.ClickEvent
+	}
+	if tc.HoverEvent != nil {
+		s.HoverEvent = tc.HoverEvent
+	}
+	return s
+}
+
+// Span is a run of text with one style.
+type Span struct {
+	Text  string
+	Style Style
+}
+
+// Renderer flattens and renders text components. The zero value renders with
+// the English translations and vanilla default key bindings.
+type Renderer struct {
+	// Translate returns the format string of a translation key, or "" if the key
+	// is unknown. Defaults to lang.Translate.
+	Translate func(key string) string
+	// Keybind returns the display name of the key bound to a key mapping such as
+	// "key.jump". Defaults to the vanilla default bindings.
+	Keybind func(name string) string
+	// Score returns the value of a score. Unresolved scores render empty, like in
+	// the vanilla client, where the server resolves them.
+	Score func(name, objective string) (string, bool)
+}
+
+// defaultRenderer is the renderer of the package-level functions.
+var defaultRenderer Renderer
+
+// Flatten returns the text of a component as spans in reading order.
+func Flatten(tc ns.TextComponent) []Span {
+	return defaultRenderer.Flatten(tc)
+}
+
+// Flatten returns the text of a component as spans in reading order. Adjacent
+// spans with the same style are merged and empty spans are dropped.
+func (r *Renderer) Flatten(tc ns.TextComponent) []Span {
+	var spans []Span
+	r.flatten(&tc, Style{}, &spans)
+	return spans
+}
+
+func (r *Renderer) flatten(tc *ns.TextComponent, parent Style, spans *[]Span) {
+	style := parent.apply(tc)
+	emit := func(s string) {
+		if s == "" {
+			return
+		}
+		if n := len(*spans); n > 0 && (*spans)[n-1].Style == style {
+			(*spans)[n-1].Text += s
+			return
+		}
+		*spans = append(*spans, Span{Text: s, Style: style})
+	}
+	switch {
+	case tc.Translate != "":
+		r.flattenTranslation(tc, style, emit, spans)
+	case tc.Keybind != "":
+		emit(r.keybind(tc.Keybind))
+	case tc.Score != nil:
+		if r.Score != nil {
+			if v, ok := r.Score(tc.Score.Name, tc.Score.Objective); ok {
+				emit(v)
+			}
+		}
+	case tc.Selector != "":
+		// the server resolves selectors; show the pattern if it didn't
+		emit(tc.Selector)
+	default:
+		emit(tc.Text)
+	}
+	for i := range tc.Extra {
+		r.flatten(&tc.Extra[i], style, spans)
+	}
+}
+
+func (r *Renderer) flattenTranslation(tc *ns.TextComponent, style Style, emit func(string), spans *[]Span) {
+	format := r.translate(tc.Translate)
+	if format == "" {
+		format = tc.Translate
+	}
+	parts, ok := lang.ParseFormat(format, len(tc.With))
+	if !ok {
+		// like the vanilla client, show invalid formats as they are
+		emit(format)
+		return
+	}
+	for _, p := range parts {
+		if p.Arg < 0 {
+			emit(p.Literal)
+			continue
+		}
+		r.flatten(&tc.With[p.Arg], style, spans)
+	}
+}
+
+func (r *Renderer) translate(key string) string {
+	if r.Translate != nil {
+		return r.Translate(key)
+	}
+	return lang.Translate(key)
+}
+
+// defaultKeybinds are the default keys of the vanilla key mappings.
+var defaultKeybinds = map[string]string{
+	"key.attack":               "key.mouse.left",
+	"key.use":                  "key.mouse.right",
+	"key.pickItem":             "key.mouse.middle",
+	"key.forward":              "key.keyboard.w",
+	"key.left":                 "key.keyboard.a",
+	"key.back":                 "key.keyboard.s",
+	"key.right":                "key.keyboard.d",
+	"key.jump":                 "key.keyboard.space",
+	"key.sneak":                "key.keyboard.left.shift",
+	"key.sprint":               "key.keyboard.left.control",
+	"key.drop":                 "key.keyboard.q",
+	"key.inventory":            "key.keyboard.e",
+	"key.swapOffhand":          "key.keyboard.f",
+	"key.chat":                 "key.keyboard.t",
+	"key.command":              "key.keyboard.slash",
+	"key.playerlist":           "key.keyboard.tab",
+	"key.socialInteractions":   "key.keyboard.p",
+	"key.advancements":         "key.keyboard.l",
+	"key.screenshot":           "key.keyboard.f2",
+	"key.togglePerspective":    "key.keyboard.f5",
+	"key.fullscreen":           "key.keyboard.f11",
+	"key.saveToolbarActivator": "key.keyboard.c",
+	"key.loadToolbarActivator": "key.keyboard.x",
+	"key.hotbar.1":             "key.keyboard.1",
+	"key.hotbar.2":             "key.keyboard.2",
+	"key.hotbar.3":             "key.keyboard.3",
+	"key.hotbar.4":             "key.keyboard.4",
+	"key.hotbar.5":             "key.keyboard.5",
+	"key.hotbar.6":             "key.keyboard.6",
+	"key.hotbar.7":             "key.keyboard.7",
+	"key.hotbar.8":             "key.keyboard.8",
+	"key.hotbar.9":             "key.keyboard.9",
+}
+
+func (r *Renderer) keybind(name string) string {
+	if r.Keybind != nil {
+		return r.Keybind(name)
+	}
+	key, ok := defaultKeybinds[name]
+	if !ok {
+		// unknown key mappings show their translated name
+		if s := r.translate(name); s != "" {
+			return s
+		}
+		return name
+	}
+	if s := r.translate(key); s != "" {
+		return s
+	}
+	// printable keys have no translation and show the key itself
+	return strings.ToUpper(key[strings.LastIndexByte(key, '.')+1:])
+}
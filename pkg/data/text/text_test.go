@@ -0,0 +1,86 @@
+package text_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/text"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestPlain(t *testing.T) {
+	steve := ns.TextComponent{Text: "Steve"}
+	for _, tt := range []struct {
+		name string
+		tc   ns.TextComponent
+		want string
+	}{
+		{"text with extra", ns.TextComponent{Text: "Hello ", Extra: []ns.TextComponent{{Text: "World"}}}, "Hello World"},
+		{"translation", ns.NewTranslateComponent("chat.type.text", steve, ns.TextComponent{Text: "hi"}), "<Steve> hi"},
+		{"whisper", ns.NewTranslateComponent("commands.message.display.incoming", steve, ns.TextComponent{Text: "psst"}), "Steve whispers to you: psst"},
+		{"unknown key", ns.TextComponent{Translate: "no.such.key"}, "no.such.key"},
+		{"missing argument", ns.TextComponent{Translate: "chat.type.text", With: []ns.TextComponent{steve}}, "<%s> %s"},
+		{"keybind", ns.TextComponent{Text: "Press ", Extra: []ns.TextComponent{{Keybind: "key.jump"}, {Text: " or "}, {Keybind: "key.inventory"}}}, "Press Space or E"},
+		{"mouse keybind", ns.TextComponent{Keybind: "key.use"}, "Right Button"},
+		{"selector", ns.TextComponent{Selector: "@p"}, "@p"},
+		{"unresolved score", ns.TextComponent{Text: "score: ", Extra: []ns.TextComponent{{Score: &ns.Score{Name: "Steve", Objective: "kills"}}}}, "score: "},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, text.Plain(tt.tc))
+		})
+	}
+
+	r := &text.Renderer{
+		Translate: func(key string) string {
+			return map[string]string{"test.swap": "%2$s, %1$s %% %s"}[key]
+		},
+		Score: func(name, objective string) (string, bool) { return "42", name == "Steve" },
+	}
+	swap := ns.NewTranslateComponent("test.swap", ns.TextComponent{Text: "a"}, ns.TextComponent{Text: "b"})
+	assert.Equal(t, "b, a % a", r.Plain(swap))
+	assert.Equal(t, "42", r.Plain(ns.TextComponent{Score: &ns.Score{Name: "Steve", Objective: "kills"}}))
+	assert.Equal(t, "%d", r.Plain(ns.TextComponent{Translate: "%d"}), "unsupported conversions show the format")
+}
+
+func TestStyles(t *testing.T) {
+	// gold <Steve> with the bold name, then plain text
+	tc := ns.TextComponent{
+		Translate: "chat.type.text",
+		Color:     "gold",
+		With:      []ns.TextComponent{{Text: "Steve", Bold: ptr(true)}, {Text: "a<b", Color: "#1E90FF"}},
+		Extra:     []ns.TextComponent{{Text: "!", Color: "white", Italic: ptr(true)}},
+	}
+	spans := text.Flatten(tc)
+	assert.Equal(t, []text.Span{
+		{Text: "<", Style: text.Style{Color: "gold"}},
+		{Text: "Steve", Style: text.Style{Color: "gold", Bold: true}},
+		{Text: "> ", Style: text.Style{Color: "gold"}},
+		{Text: "a<b", Style: text.Style{Color: "#1E90FF"}},
+		{Text: "!", Style: text.Style{Color: "white", Italic: true}},
+	}, spans)
+
+	assert.Equal(t, "\x1b[0;33m<\x1b[0;33;1mSteve\x1b[0;33m> \x1b[0;38;2;30;144;255ma<b\x1b[0;97;3m!\x1b[0m", text.ANSI(tc))
+	assert.Equal(t, "§6<§6§lSteve§6> §x§1§e§9§0§f§fa<b§f§o!", text.Legacy(tc))
+	assert.Equal(t,
+		`<span style="color:#FFAA00">&lt;</span><span style="color:#FFAA00;font-weight:bold">Steve</span>`+
+			`<span style="color:#FFAA00">&gt; </span><span style="color:#1E90FF">a&lt;b</span>`+
+			`<span style="color:#FFFFFF;font-style:italic">!</span>`,
+		text.HTML(tc))
+
+	// formatting without a color needs an explicit reset
+	assert.Equal(t, "§lbold§r plain", text.Legacy(ns.TextComponent{Text: "bold", Bold: ptr(true), Extra: []ns.TextComponent{{Text: " plain", Bold: ptr(false)}}}))
+	assert.Equal(t, "plain", text.ANSI(ns.TextComponent{Text: "plain"}))
+
+	link := ns.TextComponent{Text: "docs", Underlined: ptr(true), ClickEvent: &ns.ClickEvent{Action: "open_url", URL: "https://example.com/?a=1&b=2"}}
+	assert.Equal(t, `<a href="https://example.com/?a=1&amp;b=2"><span style="text-decoration:underline">docs</span></a>`, text.HTML(link))
+
+	// only http and https links are clickable
+	link.ClickEvent.URL = "javascript:alert(1)"
+	assert.Equal(t, `<span style="text-decoration:underline">docs</span>`, text.HTML(link))
+	link.ClickEvent.URL = "data:text/html,<script>alert(1)</script>"
+	assert.Equal(t, `<span style="text-decoration:underline">docs</span>`, text.HTML(link))
+}
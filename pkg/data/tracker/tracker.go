@@ -0,0 +1,359 @@
+// Package tracker keeps client-side entity state up to date from play packets.
+package tracker
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/data/entities"
+	"github.com/go-mclib/data/pkg/data/hitboxes"
+	entityhitboxes "github.com/go-mclib/data/pkg/data/hitboxes/entities"
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// NoVehicle is the Vehicle of an entity that is not riding anything.
+const NoVehicle = -1
+
+// Entity is the tracked state of an entity.
+type Entity struct {
+	ID   int32
+	UUID ns.UUID
+	// Type is the entity type identifier, e.g. "minecraft:zombie".
+	Type string
+	// Data is the type-specific data of S2CAddEntity, e.g. the block state of a falling block.
+	Data int32
+
+	X, Y, Z float64
+	// Yaw, Pitch and HeadYaw are in degrees.
+	Yaw, Pitch, HeadYaw float32
+	// VelocityX, VelocityY and VelocityZ are in blocks per tick.
+	VelocityX, VelocityY, VelocityZ float64
+	OnGround                        bool
+
+	// Metadata holds every metadata entry received so far.
+	Metadata entities.Metadata
+
+	Passengers []int32
+	// Vehicle is the ID of the entity being ridden, or NoVehicle.
+	Vehicle int32
+
+	// baseX, baseY and baseZ are the position relative moves are decoded against.
+	baseX, baseY, baseZ float64
+}
+
+// Hitbox returns the standing hitbox of the entity.
+func (e *Entity) Hitbox() hitboxes.AABB {
+	width, height, _ := entityhitboxes.Dimensions(e.Type)
+	w := float64(width) / 2
+	return hitboxes.AABB{
+		MinX: e.X - w, MinY: e.Y, MinZ: e.Z - w,
+		MaxX: e.X + w, MaxY: e.Y + float64(height), MaxZ: e.Z + w,
+	}
+}
+
+// EyeY returns the Y coordinate of the entity's eyes.
+func (e *Entity) EyeY() float64 {
+	return e.Y + float64(entityhitboxes.EyeHeight(e.Type))
+}
+
+// DistanceSquared returns the squared distance from the entity's feet to a point.
+func (e *Entity) DistanceSquared(x, y, z float64) float64 {
+	dx, dy, dz := e.X-x, e.Y-y, e.Z-z
+	return dx*dx + dy*dy + dz*dz
+}
+
+func (e *Entity) setPosition(x, y, z float64) {
+	e.X, e.Y, e.Z = x, y, z
+	e.baseX, e.baseY, e.baseZ = x, y, z
+}
+
+// EntityTracker tracks the entities the server has sent to the client.
+type EntityTracker struct {
+	entities map[int32]*Entity
+}
+
+// NewEntityTracker returns an empty tracker.
+func NewEntityTracker() *EntityTracker {
+	return &EntityTracker{entities: make(map[int32]*Entity)}
+}
+
+// ApplyAddEntity starts tracking an entity, replacing any entity with the same ID.
+func (t *EntityTracker) ApplyAddEntity(p *packets.S2CAddEntity) *Entity {
+	t.remove(int32(p.EntityId))
+	e := &Entity{
+		ID:        int32(p.EntityId),
+		UUID:      p.EntityUuid,
+		Type:      entities.EntityTypeName(int32(p.Type)),
+		Data:      int32(p.Data),
+		Yaw:       float32(p.Yaw.Degrees()),
+		Pitch:     float32(p.Pitch.Degrees()),
+		HeadYaw:   float32(p.HeadYaw.Degrees()),
+		VelocityX: p.Velocity.X,
+		VelocityY: p.Velocity.Y,
+		VelocityZ: p.Velocity.Z,
+		Vehicle:   NoVehicle,
+	}
+	e.setPosition(float64(p.X), float64(p.Y), float64(p.Z))
+	for _, v := range t.entities {
+		if slices.Contains(v.Passengers, e.ID) {
+			e.Vehicle = v.ID
+		}
+	}
+	t.entities[e.ID] = e
+	return e
+}
+
+// ApplyMoveEntityPos applies a relative move.
+func (t *EntityTracker) ApplyMoveEntityPos(p *packets.S2CMoveEntityPos) {
+	if e := t.entities[int32(p.EntityId)]; e != nil {
+		e.move(p.DeltaX, p.DeltaY, p.DeltaZ)
+		e.OnGround = bool(p.OnGround)
+	}
+}
+
+// ApplyMoveEntityPosRot applies a relative move and an absolute rotation.
+func (t *EntityTracker) ApplyMoveEntityPosRot(p *packets.S2CMoveEntityPosRot) {
+	if e := t.entities[int32(p.EntityId)]; e != nil {
+		e.move(p.DeltaX, p.DeltaY, p.DeltaZ)
+		e.Yaw, e.Pitch = float32(p.Yaw.Degrees()), float32(p.Pitch.Degrees())
+		e.OnGround = bool(p.OnGround)
+	}
+}
+
+// ApplyMoveEntityRot applies an absolute rotation.
+func (t *EntityTracker) ApplyMoveEntityRot(p *packets.S2CMoveEntityRot) {
+	if e := t.entities[int32(p.EntityId)]; e != nil {
+		e.Yaw, e.Pitch = float32(p.Yaw.Degrees()), float32(p.Pitch.Degrees())
+		e.OnGround = bool(p.OnGround)
+	}
+}
+
+// ApplyRotateHead sets the head yaw.
+func (t *EntityTracker) ApplyRotateHead(p *packets.S2CRotateHead) {
+	if e := t.entities[int32(p.EntityId)]; e != nil {
+		e.HeadYaw = float32(p.HeadYaw.Degrees())
+	}
+}
+
+// move decodes a relative move like vanilla: each non-zero delta is added, in 1/4096
+// block units, to the last absolute position rounded to that precision.
+func (e *Entity) move(dx, dy, dz ns.Int16) {
+	if dx == 0 && dy == 0 && dz == 0 {
+		return
+	}
+	e.setPosition(decodeDelta(e.baseX, dx), decodeDelta(e.baseY, dy), decodeDelta(e.baseZ, dz))
+}
+
+func decodeDelta(base float64, delta ns.Int16) float64 {
+	if delta == 0 {
+		return base
+	}
+	return float64(int64(math.Round(base*4096))+int64(delta)) / 4096
+}
+
+// ApplyTeleportEntity applies a teleport, resolving the fields marked as relative
+// by the packet flags against the current state.
+func (t *EntityTracker) ApplyTeleportEntity(p *packets.S2CTeleportEntity) {
+	e := t.entities[int32(p.EntityId)]
+	if e == nil {
+		return
+	}
+	relative := func(flag ns.Int32, current, value float64) float64 {
+		if p.Flags&flag != 0 {
+			return current + value
+		}
+		return value
+	}
+	x := relative(packets.TeleportRelativeX, e.X, float64(p.X))
+	y := relative(packets.TeleportRelativeY, e.Y, float64(p.Y))
+	z := relative(packets.TeleportRelativeZ, e.Z, float64(p.Z))
+	yaw := float32(relative(packets.TeleportRelativeYaw, float64(e.Yaw), float64(p.Yaw)))
+	pitch := float32(relative(packets.TeleportRelativePitch, float64(e.Pitch), float64(p.Pitch)))
+
+	vx, vy, vz := e.VelocityX, e.VelocityY, e.VelocityZ
+	if p.Flags&packets.TeleportRotateVelocity != 0 {
+		vx, vy, vz = rotateVelocity(vx, vy, vz, e.Yaw-yaw, e.Pitch-pitch)
+	}
+	e.VelocityX = relative(packets.TeleportRelativeVelocityX, vx, float64(p.VelocityX))
+	e.VelocityY = relative(packets.TeleportRelativeVelocityY, vy, float64(p.VelocityY))
+	e.VelocityZ = relative(packets.TeleportRelativeVelocityZ, vz, float64(p.VelocityZ))
+
+	e.setPosition(x, y, z)
+	e.Yaw, e.Pitch = yaw, pitch
+	e.OnGround = bool(p.OnGround)
+}
+
+// rotateVelocity rotates a velocity by the given yaw and pitch differences in degrees,
+// around the X axis first, like vanilla Vec3.xRot and Vec3.yRot.
+func rotateVelocity(x, y, z float64, dYaw, dPitch float32) (float64, float64, float64) {
+	sin, cos := math.Sincos(float64(dPitch) * math.Pi / 180)
+	y, z = y*cos+z*sin, z*cos-y*sin
+	sin, cos = math.Sincos(float64(dYaw) * math.Pi / 180)
+	x, z = x*cos+z*sin, z*cos-x*sin
+	return x, y, z
+}
+
+// ApplyEntityPositionSync sets the absolute position, velocity and rotation.
+func (t *EntityTracker) ApplyEntityPositionSync(p *packets.S2CEntityPositionSync) {
+	e := t.entities[int32(p.EntityId)]
+	if e == nil {
+		return
+	}
+	e.setPosition(float64(p.X), float64(p.Y), float64(p.Z))
+	e.VelocityX, e.VelocityY, e.VelocityZ = float64(p.VelocityX), float64(p.VelocityY), float64(p.VelocityZ)
+	e.Yaw, e.Pitch = float32(p.Yaw), float32(p.Pitch)
+	e.OnGround = bool(p.OnGround)
+}
+
+// ApplySetEntityMotion sets the velocity.
+func (t *EntityTracker) ApplySetEntityMotion(p *packets.S2CSetEntityMotion) {
+	if e := t.entities[int32(p.EntityId)]; e != nil {
+		e.VelocityX, e.VelocityY, e.VelocityZ = p.Velocity.X, p.Velocity.Y, p.Velocity.Z
+	}
+}
+
+// ApplySetEntityData merges the changed metadata entries into the entity metadata.
+func (t *EntityTracker) ApplySetEntityData(p *packets.S2CSetEntityData) {
+	e := t.entities[int32(p.EntityId)]
+	if e == nil {
+		return
+	}
+	for _, entry := range p.Metadata {
+		e.Metadata.Set(entry.Index, entry.Serializer, entry.Data)
+	}
+}
+
+// ApplyRemoveEntities stops tracking the entities and dismounts their passengers.
+func (t *EntityTracker) ApplyRemoveEntities(p *packets.S2CRemoveEntities) {
+	for _, id := range p.EntityIds {
+		t.remove(int32(id))
+	}
+}
+
+func (t *EntityTracker) remove(id int32) {
+	e := t.entities[id]
+	if e == nil {
+		return
+	}
+	t.dismount(e)
+	for _, passenger := range e.Passengers {
+		if p := t.entities[passenger]; p != nil && p.Vehicle == id {
+			p.Vehicle = NoVehicle
+		}
+	}
+	delete(t.entities, id)
+}
+
+// dismount removes the entity from the passengers of its vehicle.
+func (t *EntityTracker) dismount(e *Entity) {
+	if v := t.entities[e.Vehicle]; v != nil {
+		v.Passengers = slices.DeleteFunc(v.Passengers, func(id int32) bool { return id == e.ID })
+	}
+	e.Vehicle = NoVehicle
+}
+
+// ApplySetPassengers replaces the passengers of a vehicle. Passengers that are not
+// tracked are kept, so they are linked once they are added.
+func (t *EntityTracker) ApplySetPassengers(p *packets.S2CSetPassengers) {
+	v := t.entities[int32(p.EntityId)]
+	if v == nil {
+		return
+	}
+	for _, id := range v.Passengers {
+		if passenger := t.entities[id]; passenger != nil && passenger.Vehicle == v.ID {
+			passenger.Vehicle = NoVehicle
+		}
+	}
+	v.Passengers = make([]int32, 0, len(p.Passengers))
+	for _, id := range p.Passengers {
+		if passenger := t.entities[int32(id)]; passenger != nil {
+			t.dismount(passenger)
+			passenger.Vehicle = v.ID
+		}
+		v.Passengers = append(v.Passengers, int32(id))
+	}
+}
+
+// Entity returns the entity with the given ID, or nil if it is not tracked.
+func (t *EntityTracker) Entity(id int32) *Entity {
+	return t.entities[id]
+}
+
+// EntityByUUID returns the entity with the given UUID, or nil if it is not tracked.
+func (t *EntityTracker) EntityByUUID(uuid ns.UUID) *Entity {
+	for _, e := range t.entities {
+		if e.UUID == uuid {
+			return e
+		}
+	}
+	return nil
+}
+
+// Vehicle returns the entity ridden by e, or nil.
+func (t *EntityTracker) Vehicle(e *Entity) *Entity {
+	return t.entities[e.Vehicle]
+}
+
+// Len returns the number of tracked entities.
+func (t *EntityTracker) Len() int {
+	return len(t.entities)
+}
+
+// Entities returns the tracked entities sorted by ID.
+func (t *EntityTracker) Entities() []*Entity {
+	return t.Filter(nil)
+}
+
+// Filter returns the tracked entities matching keep, sorted by ID. A nil keep
+// matches every entity.
+func (t *EntityTracker) Filter(keep func(*Entity) bool) []*Entity {
+	var list []*Entity
+	for _, e := range t.entities {
+		if keep == nil || keep(e) {
+			list = append(list, e)
+		}
+	}
+	slices.SortFunc(list, func(a, b *Entity) int { return cmp.Compare(a.ID, b.ID) })
+	return list
+}
+
+// OfType returns the tracked entities of the given type, sorted by ID.
+func (t *EntityTracker) OfType(entityType string) []*Entity {
+	return t.Filter(func(e *Entity) bool { return e.Type == entityType })
+}
+
+// WithinRadius returns the entities whose feet are within radius of a point,
+// nearest first.
+func (t *EntityTracker) WithinRadius(x, y, z, radius float64) []*Entity {
+	list := t.Filter(func(e *Entity) bool { return e.DistanceSquared(x, y, z) <= radius*radius })
+	slices.SortStableFunc(list, func(a, b *Entity) int {
+		return cmp.Compare(a.DistanceSquared(x, y, z), b.DistanceSquared(x, y, z))
+	})
+	return list
+}
+
+// Intersecting returns the entities whose hitbox intersects the box, sorted by ID.
+func (t *EntityTracker) Intersecting(box hitboxes.AABB) []*Entity {
+	return t.Filter(func(e *Entity) bool {
+		h := e.Hitbox()
+		return h.MinX < box.MaxX && h.MaxX > box.MinX &&
+			h.MinY < box.MaxY && h.MaxY > box.MinY &&
+			h.MinZ < box.MaxZ && h.MaxZ > box.MinZ
+	})
+}
+
+// Nearest returns the entity nearest to a point that matches keep, or nil. A nil
+// keep matches every entity.
+func (t *EntityTracker) Nearest(x, y, z float64, keep func(*Entity) bool) *Entity {
+	var nearest *Entity
+	best := math.Inf(1)
+	for _, e := range t.Filter(keep) {
+		if d := e.DistanceSquared(x, y, z); d < best {
+			nearest, best = e, d
+		}
+	}
+	return nearest
+}
@@ -0,0 +1,155 @@
+package tracker_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/entities"
+	"github.com/go-mclib/data/pkg/data/hitboxes"
+	"github.com/go-mclib/data/pkg/data/tracker"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func addEntity(tr *tracker.EntityTracker, id int32, entityType string, x, y, z float64) *tracker.Entity {
+	return tr.ApplyAddEntity(&packets.S2CAddEntity{
+		EntityId: ns.VarInt(id),
+		Type:     ns.VarInt(entities.EntityTypeID(entityType)),
+		X:        ns.Float64(x),
+		Y:        ns.Float64(y),
+		Z:        ns.Float64(z),
+		Yaw:      64,
+		HeadYaw:  64,
+	})
+}
+
+func TestAddAndMove(t *testing.T) {
+	tr := tracker.NewEntityTracker()
+	e := addEntity(tr, 7, "minecraft:zombie", 10.3, 64, -5.7)
+	assert.Equal(t, "minecraft:zombie", e.Type)
+	assert.Equal(t, float32(90), e.Yaw)
+	assert.Equal(t, tracker.NoVehicle, int(e.Vehicle))
+
+	// deltas are applied to the base position rounded to 1/4096 of a block (10.3 is 42188.8/4096)
+	tr.ApplyMoveEntityPos(&packets.S2CMoveEntityPos{EntityId: 7, DeltaX: 4096, DeltaZ: -2048, OnGround: true})
+	assert.InDelta(t, 11.3, e.X, 1.0/4096)
+	assert.Equal(t, 64.0, e.Y, "a zero delta keeps the exact coordinate")
+	assert.InDelta(t, -6.2, e.Z, 1.0/4096)
+	assert.True(t, e.OnGround)
+
+	// many small moves do not drift
+	for range 100 {
+		tr.ApplyMoveEntityPosRot(&packets.S2CMoveEntityPosRot{EntityId: 7, DeltaX: 41, Yaw: 128, Pitch: 32})
+	}
+	assert.Equal(t, (42189.0+4096+4100)/4096, e.X)
+	assert.Equal(t, float32(180), e.Yaw)
+	assert.Equal(t, float32(45), e.Pitch)
+
+	tr.ApplyMoveEntityRot(&packets.S2CMoveEntityRot{EntityId: 7, Yaw: 0, Pitch: 0})
+	tr.ApplyRotateHead(&packets.S2CRotateHead{EntityId: 7, HeadYaw: 192})
+	assert.Equal(t, float32(0), e.Yaw)
+	assert.Equal(t, float32(270), e.HeadYaw)
+
+	// packets for unknown entities are ignored
+	tr.ApplyMoveEntityPos(&packets.S2CMoveEntityPos{EntityId: 99, DeltaX: 1})
+	assert.Equal(t, 1, tr.Len())
+}
+
+func TestTeleportAndSync(t *testing.T) {
+	tr := tracker.NewEntityTracker()
+	e := addEntity(tr, 1, "minecraft:pig", 0, 64, 0)
+
+	tr.ApplyEntityPositionSync(&packets.S2CEntityPositionSync{
+		EntityId: 1, X: 100.5, Y: 70, Z: -20.25, VelocityX: 0.1, Yaw: 45, Pitch: 10, OnGround: true,
+	})
+	assert.Equal(t, 100.5, e.X)
+	assert.Equal(t, 0.1, e.VelocityX)
+	assert.Equal(t, float32(45), e.Yaw)
+
+	// relative moves continue from the synced position
+	tr.ApplyMoveEntityPos(&packets.S2CMoveEntityPos{EntityId: 1, DeltaY: 2048})
+	assert.Equal(t, 70.5, e.Y)
+
+	tr.ApplyTeleportEntity(&packets.S2CTeleportEntity{
+		EntityId:  1,
+		X:         1,
+		Y:         80,
+		Z:         -1,
+		VelocityX: 0.5,
+		Yaw:       10,
+		Flags:     packets.TeleportRelativeX | packets.TeleportRelativeZ | packets.TeleportRelativeYaw | packets.TeleportRelativeVelocityX,
+	})
+	assert.Equal(t, 101.5, e.X)
+	assert.Equal(t, 80.0, e.Y)
+	assert.Equal(t, -21.25, e.Z)
+	assert.Equal(t, float32(55), e.Yaw)
+	assert.Equal(t, float32(0), e.Pitch)
+	assert.InDelta(t, 0.6, e.VelocityX, 1e-9)
+
+	// rotating the velocity by -90 degrees of yaw turns +X into +Z
+	tr.ApplySetEntityMotion(&packets.S2CSetEntityMotion{EntityId: 1, Velocity: ns.LpVec3{X: 1}})
+	assert.InDelta(t, 1, e.VelocityX, 1e-3)
+	tr.ApplyTeleportEntity(&packets.S2CTeleportEntity{
+		EntityId: 1, X: 101.5, Y: 80, Z: -21.25, Yaw: 145,
+		Flags: packets.TeleportRotateVelocity | packets.TeleportRelativeVelocityX | packets.TeleportRelativeVelocityY | packets.TeleportRelativeVelocityZ,
+	})
+	assert.InDelta(t, 0, e.VelocityX, 1e-3)
+	assert.InDelta(t, 1, e.VelocityZ, 1e-3)
+}
+
+func TestMetadata(t *testing.T) {
+	tr := tracker.NewEntityTracker()
+	e := addEntity(tr, 1, "minecraft:creeper", 0, 64, 0)
+
+	tr.ApplySetEntityData(&packets.S2CSetEntityData{EntityId: 1, Metadata: entities.Metadata{
+		{Index: entities.EntityIndexFlags, Serializer: entities.SerializerBYTE, Data: []byte{0x01}},
+		{Index: entities.CreeperIndexIsPowered, Serializer: entities.SerializerBOOLEAN, Data: []byte{1}},
+	}})
+	tr.ApplySetEntityData(&packets.S2CSetEntityData{EntityId: 1, Metadata: entities.Metadata{
+		{Index: entities.EntityIndexFlags, Serializer: entities.SerializerBYTE, Data: []byte{0x02}},
+	}})
+	assert.Equal(t, []byte{0x02}, e.Metadata.Get(entities.EntityIndexFlags))
+	assert.Equal(t, []byte{1}, e.Metadata.Get(entities.CreeperIndexIsPowered))
+}
+
+func TestPassengersAndRemoval(t *testing.T) {
+	tr := tracker.NewEntityTracker()
+	boat := addEntity(tr, 1, "minecraft:oak_boat", 0, 63, 0)
+	player := addEntity(tr, 2, "minecraft:player", 0, 63, 0)
+
+	tr.ApplySetPassengers(&packets.S2CSetPassengers{EntityId: 1, Passengers: ns.PrefixedArray[ns.VarInt]{2, 3}})
+	assert.Equal(t, []int32{2, 3}, boat.Passengers)
+	assert.Equal(t, int32(1), player.Vehicle)
+	assert.Same(t, boat, tr.Vehicle(player))
+
+	// passengers sent before the entity itself are linked when it is added
+	pig := addEntity(tr, 3, "minecraft:pig", 0, 63, 0)
+	assert.Equal(t, int32(1), pig.Vehicle)
+
+	tr.ApplySetPassengers(&packets.S2CSetPassengers{EntityId: 1, Passengers: ns.PrefixedArray[ns.VarInt]{3}})
+	assert.Equal(t, int32(tracker.NoVehicle), player.Vehicle)
+
+	tr.ApplyRemoveEntities(&packets.S2CRemoveEntities{EntityIds: ns.PrefixedArray[ns.VarInt]{1}})
+	assert.Nil(t, tr.Entity(1))
+	assert.Equal(t, int32(tracker.NoVehicle), pig.Vehicle)
+
+	tr.ApplyRemoveEntities(&packets.S2CRemoveEntities{EntityIds: ns.PrefixedArray[ns.VarInt]{3}})
+	assert.Equal(t, []*tracker.Entity{player}, tr.Entities())
+}
+
+func TestSpatialQueries(t *testing.T) {
+	tr := tracker.NewEntityTracker()
+	far := addEntity(tr, 1, "minecraft:zombie", 20, 64, 0)
+	near := addEntity(tr, 2, "minecraft:zombie", 3, 64, 0)
+	cow := addEntity(tr, 3, "minecraft:cow", 1, 64, 1)
+
+	assert.Equal(t, []*tracker.Entity{cow, near}, tr.WithinRadius(0, 64, 0, 5))
+	assert.Equal(t, []*tracker.Entity{far, near}, tr.OfType("minecraft:zombie"))
+	assert.Same(t, near, tr.Nearest(0, 64, 0, func(e *tracker.Entity) bool { return e.Type == "minecraft:zombie" }))
+	assert.Same(t, cow, tr.Nearest(0, 64, 0, nil))
+	assert.Nil(t, tr.Nearest(0, 64, 0, func(e *tracker.Entity) bool { return false }))
+
+	box := hitboxes.AABB{MinX: 2.5, MinY: 64, MinZ: -1, MaxX: 3.5, MaxY: 65, MaxZ: 1}
+	assert.Equal(t, []*tracker.Entity{near}, tr.Intersecting(box))
+	assert.InDelta(t, 64+1.74, near.EyeY(), 1e-5)
+}
@@ -0,0 +1,192 @@
+// Package waypoints keeps the locator bar state from S2CWaypoint packets and
+// computes the bearings of waypoints relative to the player.
+package waypoints
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+
+	"github.com/go-mclib/data/pkg/packets"
+)
+
+// DefaultStyle is the waypoint style used when none is set.
+const DefaultStyle = "minecraft:default"
+
+// Waypoint is a tracked waypoint.
+type Waypoint struct {
+	ID    packets.WaypointID
+	Style string
+	// Color is the 0xRRGGBB icon color if HasColor is set; otherwise the client
+	// derives a color from the ID.
+	Color    int32
+	HasColor bool
+	// Type is packets.WaypointEmpty, WaypointVec3i, WaypointChunk or WaypointAzimuth.
+	Type ns.VarInt
+	// X, Y and Z are the block position of vec3i waypoints. For chunk waypoints,
+	// X and Z are the chunk coordinates.
+	X, Y, Z int32
+	// Azimuth is the direction of azimuth waypoints, as a yaw in radians.
+	Azimuth float32
+}
+
+func newWaypoint(w *packets.TrackedWaypoint) *Waypoint {
+	color, hasColor := w.Color.Get()
+	wp := &Waypoint{
+		ID:       w.ID,
+		Style:    string(w.Style),
+		Color:    int32(color),
+		HasColor: hasColor,
+	}
+	if wp.Style == "" {
+		wp.Style = DefaultStyle
+	}
+	wp.setPosition(w)
+	return wp
+}
+
+func (w *Waypoint) setPosition(p *packets.TrackedWaypoint) {
+	w.Type = p.Type
+	w.X, w.Y, w.Z = int32(p.X), int32(p.Y), int32(p.Z)
+	w.Azimuth = float32(p.Azimuth)
+}
+
+// Position returns the horizontal center of the waypoint target, or false for
+// azimuth and empty waypoints, which have no position.
+func (w *Waypoint) Position() (x, z float64, ok bool) {
+	switch w.Type {
+	case packets.WaypointVec3i:
+		return float64(w.X) + 0.5, float64(w.Z) + 0.5, true
+	case packets.WaypointChunk:
+		return float64(w.X)*16 + 8, float64(w.Z)*16 + 8, true
+	}
+	return 0, 0, false
+}
+
+// Yaw returns the absolute yaw in degrees from the given position towards the
+// waypoint, or false for empty waypoints.
+func (w *Waypoint) Yaw(x, z float64) (float64, bool) {
+	if w.Type == packets.WaypointAzimuth {
+		return wrapDegrees(float64(w.Azimuth) * 180 / math.Pi), true
+	}
+	tx, tz, ok := w.Position()
+	if !ok {
+		return 0, false
+	}
+	return math.Atan2(-(tx-x), tz-z) * 180 / math.Pi, true
+}
+
+// Bearing returns the angle in degrees, in [-180, 180), between the player's view
+// direction and the waypoint. Positive angles are to the right.
+func (w *Waypoint) Bearing(x, z float64, yaw float32) (float64, bool) {
+	target, ok := w.Yaw(x, z)
+	if !ok {
+		return 0, false
+	}
+	return wrapDegrees(target - float64(yaw)), true
+}
+
+// Distance returns the distance from the given position to the waypoint: to the
+// block center for vec3i waypoints and horizontally to the chunk center for chunk
+// waypoints. Azimuth and empty waypoints have no distance.
+func (w *Waypoint) Distance(x, y, z float64) (float64, bool) {
+	tx, tz, ok := w.Position()
+	if !ok {
+		return 0, false
+	}
+	dx, dz := tx-x, tz-z
+	if w.Type == packets.WaypointVec3i {
+		dy := float64(w.Y) + 0.5 - y
+		return math.Sqrt(dx*dx + dy*dy + dz*dz), true
+	}
+	return math.Sqrt(dx*dx + dz*dz), true
+}
+
+// wrapDegrees wraps an angle to [-180, 180).
+func wrapDegrees(deg float64) float64 {
+	deg = math.Mod(deg+180, 360)
+	if deg < 0 {
+		deg += 360
+	}
+	return deg - 180
+}
+
+// WaypointTracker keeps the waypoints shown on the locator bar.
+type WaypointTracker struct {
+	waypoints map[packets.WaypointID]*Waypoint
+}
+
+// NewWaypointTracker returns an empty tracker.
+func NewWaypointTracker() *WaypointTracker {
+	return &WaypointTracker{waypoints: make(map[packets.WaypointID]*Waypoint)}
+}
+
+// ApplyWaypoint tracks, untracks or updates a waypoint. Like the vanilla client,
+// updates only move an already tracked waypoint, and are ignored if the position
+// type changed.
+func (t *WaypointTracker) ApplyWaypoint(p *packets.S2CWaypoint) {
+	switch p.Operation {
+	case packets.WaypointTrack:
+		t.waypoints[p.Waypoint.ID] = newWaypoint(&p.Waypoint)
+	case packets.WaypointUntrack:
+		delete(t.waypoints, p.Waypoint.ID)
+	case packets.WaypointUpdate:
+		if w := t.waypoints[p.Waypoint.ID]; w != nil && w.Type == p.Waypoint.Type {
+			w.setPosition(&p.Waypoint)
+		}
+	}
+}
+
+// Reset removes all waypoints, e.g. on disconnect.
+func (t *WaypointTracker) Reset() {
+	clear(t.waypoints)
+}
+
+// Waypoint returns the waypoint with the given ID, or nil.
+func (t *WaypointTracker) Waypoint(id packets.WaypointID) *Waypoint {
+	return t.waypoints[id]
+}
+
+// Len returns the number of tracked waypoints.
+func (t *WaypointTracker) Len() int {
+	return len(t.waypoints)
+}
+
+// Waypoints returns the tracked waypoints, sorted by ID.
+func (t *WaypointTracker) Waypoints() []*Waypoint {
+	waypoints := make([]*Waypoint, 0, len(t.waypoints))
+	for _, w := range t.waypoints {
+		waypoints = append(waypoints, w)
+	}
+	slices.SortFunc(waypoints, func(a, b *Waypoint) int { return cmp.Compare(a.ID.String(), b.ID.String()) })
+	return waypoints
+}
+
+// Bearing is the direction of a waypoint relative to the player.
+type Bearing struct {
+	Waypoint *Waypoint
+	// Angle is the angle in degrees, in [-180, 180), from the view direction; positive is to the right.
+	Angle float64
+	// Distance is the distance to the waypoint if HasDistance is set.
+	Distance    float64
+	HasDistance bool
+}
+
+// Bearings returns the bearings of the waypoints with a direction from the player
+// position and yaw, from left to right.
+func (t *WaypointTracker) Bearings(x, y, z float64, yaw float32) []Bearing {
+	var bearings []Bearing
+	for _, w := range t.Waypoints() {
+		angle, ok := w.Bearing(x, z, yaw)
+		if !ok {
+			continue
+		}
+		b := Bearing{Waypoint: w, Angle: angle}
+		b.Distance, b.HasDistance = w.Distance(x, y, z)
+		bearings = append(bearings, b)
+	}
+	slices.SortStableFunc(bearings, func(a, b Bearing) int { return cmp.Compare(a.Angle, b.Angle) })
+	return bearings
+}
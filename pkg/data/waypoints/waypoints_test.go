@@ -0,0 +1,86 @@
+package waypoints_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/waypoints"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaypointTracker(t *testing.T) {
+	tr := waypoints.NewWaypointTracker()
+	north := packets.WaypointID{Name: "north"}
+	east := packets.WaypointID{Name: "east"}
+	tr.ApplyWaypoint(&packets.S2CWaypoint{
+		Operation: packets.WaypointTrack,
+		Waypoint:  packets.TrackedWaypoint{ID: north, Type: packets.WaypointVec3i, X: 0, Y: 64, Z: -100},
+	})
+	tr.ApplyWaypoint(&packets.S2CWaypoint{
+		Operation: packets.WaypointTrack,
+		Waypoint:  packets.TrackedWaypoint{ID: east, Style: "minecraft:bowtie", Color: ns.Some[ns.Int32](0xff0000), Type: packets.WaypointChunk, X: 10, Z: 0},
+	})
+	require.Equal(t, 2, tr.Len())
+
+	w := tr.Waypoint(north)
+	require.NotNil(t, w)
+	assert.Equal(t, waypoints.DefaultStyle, w.Style)
+	assert.False(t, w.HasColor)
+	assert.Equal(t, int32(0xff0000), tr.Waypoint(east).Color)
+
+	// facing south (yaw 0) from the origin: north is behind, east is to the left
+	bearings := tr.Bearings(0.5, 64, 0.5, 0)
+	require.Len(t, bearings, 2)
+	assert.Equal(t, north, bearings[0].Waypoint.ID)
+	assert.Equal(t, -180.0, bearings[0].Angle)
+	assert.InDelta(t, 100, bearings[0].Distance, 0.01)
+	assert.Equal(t, east, bearings[1].Waypoint.ID)
+	assert.InDelta(t, -87.44, bearings[1].Angle, 0.01)
+	assert.InDelta(t, 167.67, bearings[1].Distance, 0.01)
+
+	// facing north, the north waypoint is straight ahead and east is to the right
+	bearings = tr.Bearings(0.5, 64, 0.5, 180)
+	assert.Equal(t, north, bearings[0].Waypoint.ID)
+	assert.InDelta(t, 0, bearings[0].Angle, 1e-9)
+	assert.InDelta(t, 92.56, bearings[1].Angle, 0.01)
+
+	// updates move the waypoint, but not if the type changed
+	tr.ApplyWaypoint(&packets.S2CWaypoint{
+		Operation: packets.WaypointUpdate,
+		Waypoint:  packets.TrackedWaypoint{ID: north, Type: packets.WaypointVec3i, X: 0, Y: 70, Z: -10},
+	})
+	assert.Equal(t, int32(70), w.Y)
+	tr.ApplyWaypoint(&packets.S2CWaypoint{
+		Operation: packets.WaypointUpdate,
+		Waypoint:  packets.TrackedWaypoint{ID: north, Type: packets.WaypointAzimuth, Azimuth: 1},
+	})
+	assert.Equal(t, packets.WaypointVec3i, w.Type)
+
+	// azimuth waypoints have a direction but no distance
+	far := packets.WaypointID{IsUUID: true, UUID: ns.UUID{15: 1}}
+	tr.ApplyWaypoint(&packets.S2CWaypoint{
+		Operation: packets.WaypointTrack,
+		Waypoint:  packets.TrackedWaypoint{ID: far, Type: packets.WaypointAzimuth, Azimuth: math.Pi / 2},
+	})
+	angle, ok := tr.Waypoint(far).Bearing(0, 0, 45)
+	assert.True(t, ok)
+	assert.InDelta(t, 45, angle, 1e-4)
+	_, ok = tr.Waypoint(far).Distance(0, 0, 0)
+	assert.False(t, ok)
+
+	// empty waypoints are tracked but not shown
+	tr.ApplyWaypoint(&packets.S2CWaypoint{
+		Operation: packets.WaypointTrack,
+		Waypoint:  packets.TrackedWaypoint{ID: packets.WaypointID{Name: "empty"}, Type: packets.WaypointEmpty},
+	})
+	assert.Equal(t, 4, tr.Len())
+	assert.Len(t, tr.Bearings(0, 0, 0, 0), 3)
+
+	tr.ApplyWaypoint(&packets.S2CWaypoint{Operation: packets.WaypointUntrack, Waypoint: packets.TrackedWaypoint{ID: north}})
+	assert.Nil(t, tr.Waypoint(north))
+	tr.Reset()
+	assert.Zero(t, tr.Len())
+}
@@ -297,7 +297,7 @@ func (p *C2SAcceptCodeOfConduct) Write(*ns.PacketBuffer) error { return nil }
 type C2SCustomClickActionConfiguration struct {
 	// The identifier for the click action.
 	Id ns.Identifier
-	// The data to send with the click action. May be a TAG_END (0).
+	// The data to send with the click action, prefixed with its length. May be a TAG_END (0).
 	Payload nbt.Tag
 }
 
@@ -306,12 +306,7 @@ func (p *C2SCustomClickActionConfiguration) Read(buf *ns.PacketBuffer) error {
 	if p.Id, err = buf.ReadIdentifier(); err != nil {
 		return err
 	}
-	// read remaining bytes as NBT
-	remaining, err := buf.ReadByteArray(1048576)
-	if err != nil {
-		return err
-	}
-	p.Payload, err = nbt.DecodeNetwork(remaining)
+	p.Payload, err = readLengthPrefixedNBT(buf)
 	return err
 }
 
@@ -319,9 +314,5 @@ func (p *C2SCustomClickActionConfiguration) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteIdentifier(p.Id); err != nil {
 		return err
 	}
-	data, err := nbt.EncodeNetwork(p.Payload)
-	if err != nil {
-		return err
-	}
-	return buf.WriteFixedByteArray(data)
+	return writeLengthPrefixedNBT(buf, p.Payload)
 }
@@ -1,6 +1,8 @@
 package packets
 
 import (
+	"fmt"
+
 	"github.com/go-mclib/data/pkg/data/items"
 	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
 	"github.com/go-mclib/protocol/nbt"
@@ -145,13 +147,13 @@ func (p *C2SChatCommand) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Signed_Chat_Command
 type C2SChatCommandSigned struct {
-	Command      ns.String
-	Timestamp    ns.Int64
-	Salt         ns.Int64
-	Signature    ns.ByteArray
-	MessageCount ns.VarInt
-	Acknowledged *ns.FixedBitSet
-	Checksum     ns.Int8
+	Command            ns.String
+	Timestamp          ns.Int64
+	Salt               ns.Int64
+	ArgumentSignatures []ArgumentSignature
+	MessageCount       ns.VarInt
+	Acknowledged       *ns.FixedBitSet
+	Checksum           ns.Int8
 }
 
 func (p *C2SChatCommandSigned) Read(buf *ns.PacketBuffer) error {
@@ -165,13 +167,22 @@ func (p *C2SChatCommandSigned) Read(buf *ns.PacketBuffer) error {
 	if p.Salt, err = buf.ReadInt64(); err != nil {
 		return err
 	}
-	if p.Signature, err = buf.ReadByteArray(256); err != nil {
+	count, err := buf.ReadVarInt()
+	if err != nil {
 		return err
 	}
+	if count < 0 || count > maxArgumentSignatures {
+		return fmt.Errorf("too many argument signatures: %d", count)
+	}
+	p.ArgumentSignatures = make([]ArgumentSignature, count)
+	for i := range p.ArgumentSignatures {
+		if err := p.ArgumentSignatures[i].Read(buf); err != nil {
+			return err
+		}
+	}
 	if p.MessageCount, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Acknowledged = ns.NewFixedBitSet(20)
 	ackBytes, err := buf.ReadFixedByteArray(3)
 	if err != nil {
 		return err
@@ -191,9 +202,14 @@ func (p *C2SChatCommandSigned) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteInt64(p.Salt); err != nil {
 		return err
 	}
-	if err := buf.WriteByteArray(p.Signature); err != nil {
+	if err := buf.WriteVarInt(ns.VarInt(len(p.ArgumentSignatures))); err != nil {
 		return err
 	}
+	for i := range p.ArgumentSignatures {
+		if err := p.ArgumentSignatures[i].Write(buf); err != nil {
+			return err
+		}
+	}
 	if err := buf.WriteVarInt(p.MessageCount); err != nil {
 		return err
 	}
@@ -203,6 +219,36 @@ func (p *C2SChatCommandSigned) Write(buf *ns.PacketBuffer) error {
 	return buf.WriteInt8(p.Checksum)
 }
 
+// maxArgumentSignatures is the maximum number of signed arguments of a command.
+const maxArgumentSignatures = 8
+
+// ArgumentSignature is the signature of a signed command argument, such as the
+// message of /msg.
+type ArgumentSignature struct {
+	Name      ns.String
+	Signature MessageSignature
+}
+
+func (a *ArgumentSignature) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if a.Name, err = buf.ReadString(16); err != nil {
+		return err
+	}
+	sig, err := buf.ReadFixedByteArray(len(a.Signature))
+	if err != nil {
+		return err
+	}
+	copy(a.Signature[:], sig)
+	return nil
+}
+
+func (a *ArgumentSignature) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteString(a.Name); err != nil {
+		return err
+	}
+	return buf.WriteFixedByteArray(a.Signature[:])
+}
+
 // C2SChat represents "Chat Message".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Chat_Message
@@ -2122,11 +2168,7 @@ func (p *C2SCustomClickActionPlay) Read(buf *ns.PacketBuffer) error {
 	if p.Id, err = buf.ReadIdentifier(); err != nil {
 		return err
 	}
-	remaining, err := buf.ReadByteArray(1048576)
-	if err != nil {
-		return err
-	}
-	p.Payload, err = nbt.DecodeNetwork(remaining)
+	p.Payload, err = readLengthPrefixedNBT(buf)
 	return err
 }
 
@@ -2134,11 +2176,7 @@ func (p *C2SCustomClickActionPlay) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteIdentifier(p.Id); err != nil {
 		return err
 	}
-	data, err := nbt.EncodeNetwork(p.Payload)
-	if err != nil {
-		return err
-	}
-	return buf.WriteFixedByteArray(data)
+	return writeLengthPrefixedNBT(buf, p.Payload)
 }
 
 // C2SSetGameRule represents "Set Game Rule" (new in 26.1).
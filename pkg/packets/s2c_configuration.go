@@ -1,6 +1,8 @@
 package packets
 
 import (
+	"fmt"
+
 	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
 	"github.com/go-mclib/protocol/nbt"
 )
@@ -388,45 +390,77 @@ type S2CUpdateTagsConfiguration struct {
 	ArrayOfTags []TagRegistry
 }
 
-func (p *S2CUpdateTagsConfiguration) Read(buf *ns.PacketBuffer) error {
+// TagMap returns the entry IDs of each tag, keyed by tag name.
+func (r TagRegistry) TagMap() map[string][]int32 {
+	tags := make(map[string][]int32, len(r.Tags))
+	for _, tag := range r.Tags {
+		entries := make([]int32, len(tag.Entries))
+		for i, entry := range tag.Entries {
+			entries[i] = int32(entry)
+		}
+		tags[string(tag.TagName)] = entries
+	}
+	return tags
+}
+
+// readTagRegistries reads the tag payload shared by the configuration and play Update Tags packets.
+func readTagRegistries(buf *ns.PacketBuffer) ([]TagRegistry, error) {
+	// the counts come from the server, so the slices grow as entries are read
+	// instead of being allocated up front
 	registryCount, err := buf.ReadVarInt()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	p.ArrayOfTags = make([]TagRegistry, registryCount)
-	for i := range p.ArrayOfTags {
-		if p.ArrayOfTags[i].Registry, err = buf.ReadIdentifier(); err != nil {
-			return err
+	if registryCount < 0 {
+		return nil, fmt.Errorf("negative tag registry count %d", registryCount)
+	}
+	tagRegistries := []TagRegistry{}
+	for range registryCount {
+		var registry TagRegistry
+		if registry.Registry, err = buf.ReadIdentifier(); err != nil {
+			return nil, err
 		}
 		tagCount, err := buf.ReadVarInt()
 		if err != nil {
-			return err
+			return nil, err
 		}
-		p.ArrayOfTags[i].Tags = make([]Tag, tagCount)
-		for j := range p.ArrayOfTags[i].Tags {
-			if p.ArrayOfTags[i].Tags[j].TagName, err = buf.ReadIdentifier(); err != nil {
-				return err
+		if tagCount < 0 {
+			return nil, fmt.Errorf("negative tag count %d", tagCount)
+		}
+		registry.Tags = []Tag{}
+		for range tagCount {
+			var tag Tag
+			if tag.TagName, err = buf.ReadIdentifier(); err != nil {
+				return nil, err
 			}
 			entryCount, err := buf.ReadVarInt()
 			if err != nil {
-				return err
+				return nil, err
 			}
-			p.ArrayOfTags[i].Tags[j].Entries = make([]ns.VarInt, entryCount)
-			for k := range p.ArrayOfTags[i].Tags[j].Entries {
-				if p.ArrayOfTags[i].Tags[j].Entries[k], err = buf.ReadVarInt(); err != nil {
-					return err
+			if entryCount < 0 {
+				return nil, fmt.Errorf("negative tag entry count %d", entryCount)
+			}
+			tag.Entries = []ns.VarInt{}
+			for range entryCount {
+				entry, err := buf.ReadVarInt()
+				if err != nil {
+					return nil, err
 				}
+				tag.Entries = append(tag.Entries, entry)
 			}
+			registry.Tags = append(registry.Tags, tag)
 		}
+		tagRegistries = append(tagRegistries, registry)
 	}
-	return nil
+	return tagRegistries, nil
 }
 
-func (p *S2CUpdateTagsConfiguration) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteVarInt(ns.VarInt(len(p.ArrayOfTags))); err != nil {
+// writeTagRegistries writes the tag payload shared by the configuration and play Update Tags packets.
+func writeTagRegistries(buf *ns.PacketBuffer, tagRegistries []TagRegistry) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(tagRegistries))); err != nil {
 		return err
 	}
-	for _, registry := range p.ArrayOfTags {
+	for _, registry := range tagRegistries {
 		if err := buf.WriteIdentifier(registry.Registry); err != nil {
 			return err
 		}
@@ -450,6 +484,16 @@ func (p *S2CUpdateTagsConfiguration) Write(buf *ns.PacketBuffer) error {
 	return nil
 }
 
+func (p *S2CUpdateTagsConfiguration) Read(buf *ns.PacketBuffer) error {
+	var err error
+	p.ArrayOfTags, err = readTagRegistries(buf)
+	return err
+}
+
+func (p *S2CUpdateTagsConfiguration) Write(buf *ns.PacketBuffer) error {
+	return writeTagRegistries(buf, p.ArrayOfTags)
+}
+
 // S2CSelectKnownPacks represents "Clientbound Known Packs".
 //
 // Informs the client of which data packs are present on the server.
@@ -634,23 +678,55 @@ type S2CShowDialogConfiguration struct {
 }
 
 func (p *S2CShowDialogConfiguration) Read(buf *ns.PacketBuffer) error {
-	// read remaining bytes as NBT
-	remaining, err := buf.ReadByteArray(1048576)
-	if err != nil {
-		return err
-	}
-	p.Dialog, err = nbt.DecodeNetwork(remaining)
+	var err error
+	p.Dialog, err = readNBT(buf)
 	return err
 }
 
 func (p *S2CShowDialogConfiguration) Write(buf *ns.PacketBuffer) error {
-	data, err := nbt.EncodeNetwork(p.Dialog)
+	return writeNBT(buf, p.Dialog)
+}
+
+// readNBT reads a nameless NBT tag that is not length-prefixed.
+func readNBT(buf *ns.PacketBuffer) (nbt.Tag, error) {
+	tag, _, err := nbt.NewReaderFrom(buf.Reader()).ReadTag(true)
+	return tag, err
+}
+
+// writeNBT writes a nameless NBT tag without a length prefix; nil is written as TAG_End.
+func writeNBT(buf *ns.PacketBuffer, tag nbt.Tag) error {
+	if tag == nil {
+		tag = nbt.End{}
+	}
+	data, err := nbt.EncodeNetwork(tag)
 	if err != nil {
 		return err
 	}
 	return buf.WriteFixedByteArray(data)
 }
 
+// readLengthPrefixedNBT reads an optional NBT tag prefixed with its length in bytes.
+func readLengthPrefixedNBT(buf *ns.PacketBuffer) (nbt.Tag, error) {
+	data, err := buf.ReadByteArray(65536)
+	if err != nil {
+		return nil, err
+	}
+	return nbt.DecodeNetwork(data)
+}
+
+// writeLengthPrefixedNBT writes an optional NBT tag prefixed with its length in bytes;
+// nil is written as TAG_End.
+func writeLengthPrefixedNBT(buf *ns.PacketBuffer, tag nbt.Tag) error {
+	if tag == nil {
+		tag = nbt.End{}
+	}
+	data, err := nbt.EncodeNetwork(tag)
+	if err != nil {
+		return err
+	}
+	return buf.WriteByteArray(data)
+}
+
 // S2CCodeOfConduct represents "Code of Conduct".
 //
 // Show the client the server Code of Conduct.
@@ -2,10 +2,17 @@ package packets
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"math"
+	"slices"
 
+	"github.com/go-mclib/data/pkg/data/chunks"
+	"github.com/go-mclib/data/pkg/data/commands"
+	"github.com/go-mclib/data/pkg/data/debug"
 	"github.com/go-mclib/data/pkg/data/entities"
 	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/data/registries"
 	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
 	"github.com/go-mclib/protocol/nbt"
 )
@@ -133,17 +140,58 @@ func (p *S2CAnimate) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Award_Statistics
 type S2CAwardStats struct {
-	Statistics ns.ByteArray
+	Statistics []Statistic
+}
+
+// Statistic is an awarded statistic value.
+type Statistic struct {
+	// StatType is the minecraft:stat_type ID.
+	StatType ns.VarInt
+	// StatId is the ID in the stat type's registry, e.g. minecraft:block for mined.
+	StatId ns.VarInt
+	Value  ns.VarInt
 }
 
 func (p *S2CAwardStats) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.Statistics, err = buf.ReadByteArray(1048576)
-	return err
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return fmt.Errorf("negative statistic count %d", count)
+	}
+	p.Statistics = make([]Statistic, count)
+	for i := range p.Statistics {
+		s := &p.Statistics[i]
+		if s.StatType, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		if s.StatId, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		if s.Value, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (p *S2CAwardStats) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Statistics)
+	if err := buf.WriteVarInt(ns.VarInt(len(p.Statistics))); err != nil {
+		return err
+	}
+	for _, s := range p.Statistics {
+		if err := buf.WriteVarInt(s.StatType); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(s.StatId); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(s.Value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // S2CBlockChangedAck represents "Acknowledge Block Change".
@@ -534,17 +582,65 @@ func (p *S2CChunkBatchStart) Write(*ns.PacketBuffer) error { return nil }
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Chunk_Biomes
 type S2CChunksBiomes struct {
-	ChunkBiomeData ns.ByteArray
+	Chunks []ChunkBiomeData
+}
+
+// ChunkBiomeData holds the biomes of a chunk column.
+type ChunkBiomeData struct {
+	ChunkZ ns.Int32
+	ChunkX ns.Int32
+	// Biomes are the biome containers of each section, from the bottom section up.
+	Biomes []*chunks.PalettedContainer
 }
 
 func (p *S2CChunksBiomes) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.ChunkBiomeData, err = buf.ReadByteArray(1048576)
-	return err
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return fmt.Errorf("negative chunk count %d", count)
+	}
+	p.Chunks = make([]ChunkBiomeData, count)
+	for i := range p.Chunks {
+		c := &p.Chunks[i]
+		if c.ChunkZ, err = buf.ReadInt32(); err != nil {
+			return err
+		}
+		if c.ChunkX, err = buf.ReadInt32(); err != nil {
+			return err
+		}
+		data, err := buf.ReadByteArray(2097152)
+		if err != nil {
+			return err
+		}
+		if c.Biomes, err = chunks.DecodeBiomes(data); err != nil {
+			return fmt.Errorf("chunk [%d, %d]: %w", c.ChunkX, c.ChunkZ, err)
+		}
+	}
+	return nil
 }
 
 func (p *S2CChunksBiomes) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.ChunkBiomeData)
+	if err := buf.WriteVarInt(ns.VarInt(len(p.Chunks))); err != nil {
+		return err
+	}
+	for _, c := range p.Chunks {
+		if err := buf.WriteInt32(c.ChunkZ); err != nil {
+			return err
+		}
+		if err := buf.WriteInt32(c.ChunkX); err != nil {
+			return err
+		}
+		data, err := chunks.EncodeBiomes(c.Biomes)
+		if err != nil {
+			return err
+		}
+		if err := buf.WriteByteArray(data); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // S2CClearTitles represents "Clear Titles".
@@ -606,17 +702,15 @@ func (p *S2CCommandSuggestions) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Commands
 type S2CCommands struct {
-	Data ns.ByteArray
+	Graph commands.Graph
 }
 
 func (p *S2CCommands) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Graph.Read(buf)
 }
 
 func (p *S2CCommands) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+	return p.Graph.Write(buf)
 }
 
 // S2CContainerClose represents "Close Container".
@@ -897,7 +991,7 @@ func (p *S2CDamageEvent) Write(buf *ns.PacketBuffer) error {
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Debug_Block_Value
 type S2CDebugBlockValue struct {
 	Location ns.Position
-	Update   ns.ByteArray
+	Update   debug.Update
 }
 
 func (p *S2CDebugBlockValue) Read(buf *ns.PacketBuffer) error {
@@ -905,15 +999,14 @@ func (p *S2CDebugBlockValue) Read(buf *ns.PacketBuffer) error {
 	if p.Location, err = buf.ReadPosition(); err != nil {
 		return err
 	}
-	p.Update, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Update.Read(buf)
 }
 
 func (p *S2CDebugBlockValue) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WritePosition(p.Location); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Update)
+	return p.Update.Write(buf)
 }
 
 // S2CDebugChunkValue represents "Debug Chunk Value".
@@ -922,7 +1015,7 @@ func (p *S2CDebugBlockValue) Write(buf *ns.PacketBuffer) error {
 type S2CDebugChunkValue struct {
 	ChunkZ ns.Int32
 	ChunkX ns.Int32
-	Update ns.ByteArray
+	Update debug.Update
 }
 
 func (p *S2CDebugChunkValue) Read(buf *ns.PacketBuffer) error {
@@ -933,8 +1026,7 @@ func (p *S2CDebugChunkValue) Read(buf *ns.PacketBuffer) error {
 	if p.ChunkX, err = buf.ReadInt32(); err != nil {
 		return err
 	}
-	p.Update, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Update.Read(buf)
 }
 
 func (p *S2CDebugChunkValue) Write(buf *ns.PacketBuffer) error {
@@ -944,7 +1036,7 @@ func (p *S2CDebugChunkValue) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteInt32(p.ChunkX); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Update)
+	return p.Update.Write(buf)
 }
 
 // S2CDebugEntityValue represents "Debug Entity Value".
@@ -952,7 +1044,7 @@ func (p *S2CDebugChunkValue) Write(buf *ns.PacketBuffer) error {
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Debug_Entity_Value
 type S2CDebugEntityValue struct {
 	EntityId ns.VarInt
-	Update   ns.ByteArray
+	Update   debug.Update
 }
 
 func (p *S2CDebugEntityValue) Read(buf *ns.PacketBuffer) error {
@@ -960,58 +1052,82 @@ func (p *S2CDebugEntityValue) Read(buf *ns.PacketBuffer) error {
 	if p.EntityId, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Update, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Update.Read(buf)
 }
 
 func (p *S2CDebugEntityValue) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteVarInt(p.EntityId); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Update)
+	return p.Update.Write(buf)
 }
 
 // S2CDebugEvent represents "Debug Event".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Debug_Event
 type S2CDebugEvent struct {
-	Event ns.ByteArray
+	Event debug.Event
 }
 
 func (p *S2CDebugEvent) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.Event, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Event.Read(buf)
 }
 
 func (p *S2CDebugEvent) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Event)
+	return p.Event.Write(buf)
 }
 
 // S2CDebugSample represents "Debug Sample".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Debug_Sample
 type S2CDebugSample struct {
-	Sample     ns.ByteArray
+	Sample []ns.Int64
+	// SampleType is debug.SampleTickTime.
 	SampleType ns.VarInt
 }
 
 func (p *S2CDebugSample) Read(buf *ns.PacketBuffer) error {
-	var err error
-	if p.Sample, err = buf.ReadByteArray(1048576); err != nil {
+	count, err := buf.ReadVarInt()
+	if err != nil {
 		return err
 	}
+	if count < 0 {
+		return fmt.Errorf("negative sample length %d", count)
+	}
+	p.Sample = make([]ns.Int64, count)
+	for i := range p.Sample {
+		if p.Sample[i], err = buf.ReadInt64(); err != nil {
+			return err
+		}
+	}
 	p.SampleType, err = buf.ReadVarInt()
 	return err
 }
 
 func (p *S2CDebugSample) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteByteArray(p.Sample); err != nil {
+	if err := buf.WriteVarInt(ns.VarInt(len(p.Sample))); err != nil {
 		return err
 	}
+	for _, v := range p.Sample {
+		if err := buf.WriteInt64(v); err != nil {
+			return err
+		}
+	}
 	return buf.WriteVarInt(p.SampleType)
 }
 
+// TickTimeSample parses a debug.SampleTickTime sample.
+func (p *S2CDebugSample) TickTimeSample() (debug.TickTimeSample, error) {
+	if int32(p.SampleType) != debug.SampleTickTime {
+		return debug.TickTimeSample{}, fmt.Errorf("debug sample type %d is not tick time", p.SampleType)
+	}
+	sample := make([]int64, len(p.Sample))
+	for i, v := range p.Sample {
+		sample[i] = int64(v)
+	}
+	return debug.ParseTickTimeSample(sample)
+}
+
 // S2CDeleteChat represents "Delete Message".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Delete_Message
@@ -1062,10 +1178,8 @@ func (p *S2CDisconnectPlay) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Disguised_Chat_Message
 type S2CDisguisedChat struct {
-	Message    ns.TextComponent
-	ChatType   ns.ByteArray
-	SenderName ns.TextComponent
-	TargetName ns.PrefixedOptional[ns.TextComponent]
+	Message  ns.TextComponent
+	ChatType ChatTypeBound
 }
 
 func (p *S2CDisguisedChat) Read(buf *ns.PacketBuffer) error {
@@ -1073,30 +1187,14 @@ func (p *S2CDisguisedChat) Read(buf *ns.PacketBuffer) error {
 	if p.Message, err = buf.ReadTextComponent(); err != nil {
 		return err
 	}
-	if p.ChatType, err = buf.ReadByteArray(1048576); err != nil {
-		return err
-	}
-	if p.SenderName, err = buf.ReadTextComponent(); err != nil {
-		return err
-	}
-	return p.TargetName.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.TextComponent, error) {
-		return b.ReadTextComponent()
-	})
+	return p.ChatType.Read(buf)
 }
 
 func (p *S2CDisguisedChat) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteTextComponent(p.Message); err != nil {
 		return err
 	}
-	if err := buf.WriteByteArray(p.ChatType); err != nil {
-		return err
-	}
-	if err := buf.WriteTextComponent(p.SenderName); err != nil {
-		return err
-	}
-	return p.TargetName.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.TextComponent) error {
-		return b.WriteTextComponent(v)
-	})
+	return p.ChatType.Write(buf)
 }
 
 // S2CEntityEvent represents "Entity Event".
@@ -1207,10 +1305,82 @@ func (p *S2CEntityPositionSync) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Explosion
 type S2CExplode struct {
-	X    ns.Float64
-	Y    ns.Float64
-	Z    ns.Float64
-	Data ns.ByteArray
+	X      ns.Float64
+	Y      ns.Float64
+	Z      ns.Float64
+	Radius ns.Float32
+	// BlockCount is the number of blocks destroyed.
+	BlockCount ns.Int32
+	// PlayerKnockback is the velocity added to the receiving player, if they were affected.
+	PlayerKnockback   ns.PrefixedOptional[Vec3]
+	ExplosionParticle entities.Particle
+	ExplosionSound    SoundEventHolder
+	BlockParticles    ns.PrefixedArray[ExplosionParticle]
+}
+
+// Vec3 is a double precision vector.
+type Vec3 struct {
+	X ns.Float64
+	Y ns.Float64
+	Z ns.Float64
+}
+
+func (v *Vec3) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if v.X, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if v.Y, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	v.Z, err = buf.ReadFloat64()
+	return err
+}
+
+func (v Vec3) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteFloat64(v.X); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(v.Y); err != nil {
+		return err
+	}
+	return buf.WriteFloat64(v.Z)
+}
+
+// ExplosionParticle is a weighted entry of the block particles shown by an explosion.
+type ExplosionParticle struct {
+	Particle entities.Particle
+	Scaling  ns.Float32
+	Speed    ns.Float32
+	Weight   ns.VarInt
+}
+
+func (e *ExplosionParticle) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if err = e.Particle.Read(buf); err != nil {
+		return err
+	}
+	if e.Scaling, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	if e.Speed, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	e.Weight, err = buf.ReadVarInt()
+	return err
+}
+
+func (e ExplosionParticle) Write(buf *ns.PacketBuffer) error {
+	if err := e.Particle.Write(buf); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(e.Scaling); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(e.Speed); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(e.Weight)
 }
 
 func (p *S2CExplode) Read(buf *ns.PacketBuffer) error {
@@ -1224,8 +1394,30 @@ func (p *S2CExplode) Read(buf *ns.PacketBuffer) error {
 	if p.Z, err = buf.ReadFloat64(); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if p.Radius, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	if p.BlockCount, err = buf.ReadInt32(); err != nil {
+		return err
+	}
+	if err = p.PlayerKnockback.DecodeWith(buf, func(b *ns.PacketBuffer) (Vec3, error) {
+		var v Vec3
+		err := v.Read(b)
+		return v, err
+	}); err != nil {
+		return err
+	}
+	if err = p.ExplosionParticle.Read(buf); err != nil {
+		return err
+	}
+	if err = p.ExplosionSound.Read(buf); err != nil {
+		return err
+	}
+	return p.BlockParticles.DecodeWith(buf, func(b *ns.PacketBuffer) (ExplosionParticle, error) {
+		var e ExplosionParticle
+		err := e.Read(b)
+		return e, err
+	})
 }
 
 func (p *S2CExplode) Write(buf *ns.PacketBuffer) error {
@@ -1238,7 +1430,22 @@ func (p *S2CExplode) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteFloat64(p.Z); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
+	if err := buf.WriteFloat32(p.Radius); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(p.BlockCount); err != nil {
+		return err
+	}
+	if err := p.PlayerKnockback.EncodeWith(buf, func(b *ns.PacketBuffer, v Vec3) error { return v.Write(b) }); err != nil {
+		return err
+	}
+	if err := p.ExplosionParticle.Write(buf); err != nil {
+		return err
+	}
+	if err := p.ExplosionSound.Write(buf); err != nil {
+		return err
+	}
+	return p.BlockParticles.EncodeWith(buf, func(b *ns.PacketBuffer, e ExplosionParticle) error { return e.Write(b) })
 }
 
 // S2CForgetLevelChunk represents "Unload Chunk".
@@ -1533,8 +1740,7 @@ type S2CLevelParticles struct {
 	OffsetZ       ns.Float32
 	MaxSpeed      ns.Float32
 	ParticleCount ns.Int32
-	ParticleId    ns.VarInt
-	Data          ns.ByteArray
+	Particle      entities.Particle
 }
 
 func (p *S2CLevelParticles) Read(buf *ns.PacketBuffer) error {
@@ -1569,11 +1775,7 @@ func (p *S2CLevelParticles) Read(buf *ns.PacketBuffer) error {
 	if p.ParticleCount, err = buf.ReadInt32(); err != nil {
 		return err
 	}
-	if p.ParticleId, err = buf.ReadVarInt(); err != nil {
-		return err
-	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Particle.Read(buf)
 }
 
 func (p *S2CLevelParticles) Write(buf *ns.PacketBuffer) error {
@@ -1607,10 +1809,7 @@ func (p *S2CLevelParticles) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteInt32(p.ParticleCount); err != nil {
 		return err
 	}
-	if err := buf.WriteVarInt(p.ParticleId); err != nil {
-		return err
-	}
-	return buf.WriteByteArray(p.Data)
+	return p.Particle.Write(buf)
 }
 
 // S2CLightUpdate represents "Update Light".
@@ -1806,7 +2005,35 @@ func (p *S2CLogin) Write(buf *ns.PacketBuffer) error {
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Map_Data
 type S2CMapItemData struct {
 	MapId ns.VarInt
-	Data  ns.ByteArray
+	// Scale is the zoom level, from 0 (1 block per pixel) to 4 (16x16 blocks per pixel).
+	Scale  ns.Int8
+	Locked ns.Boolean
+	// Decorations replace all decorations of the map if present.
+	Decorations ns.PrefixedOptional[ns.PrefixedArray[MapDecoration]]
+	// ColorPatch is nil if no pixels changed.
+	ColorPatch *MapColorPatch
+}
+
+// MapDecoration is an icon shown on a map.
+type MapDecoration struct {
+	// Type is the minecraft:map_decoration_type registry ID.
+	Type ns.VarInt
+	// X and Y are from -128 to 127, in half pixels from the map center.
+	X ns.Int8
+	Y ns.Int8
+	// Rotation is from 0 to 15, in steps of 22.5 degrees clockwise.
+	Rotation    ns.Int8
+	DisplayName ns.PrefixedOptional[ns.TextComponent]
+}
+
+// MapColorPatch is a rectangle of updated map pixels.
+type MapColorPatch struct {
+	Width  ns.Uint8
+	Height ns.Uint8
+	StartX ns.Uint8
+	StartY ns.Uint8
+	// Colors are packed map colors (base color * 4 + brightness), row by row.
+	Colors ns.ByteArray
 }
 
 func (p *S2CMapItemData) Read(buf *ns.PacketBuffer) error {
@@ -1814,91 +2041,482 @@ func (p *S2CMapItemData) Read(buf *ns.PacketBuffer) error {
 	if p.MapId, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if p.Scale, err = buf.ReadInt8(); err != nil {
+		return err
+	}
+	if p.Locked, err = buf.ReadBool(); err != nil {
+		return err
+	}
+	if err = p.Decorations.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.PrefixedArray[MapDecoration], error) {
+		var decorations ns.PrefixedArray[MapDecoration]
+		err := decorations.DecodeWith(b, func(b *ns.PacketBuffer) (MapDecoration, error) {
+			var d MapDecoration
+			err := d.Read(b)
+			return d, err
+		})
+		return decorations, err
+	}); err != nil {
+		return err
+	}
+	width, err := buf.ReadUint8()
+	if err != nil {
+		return err
+	}
+	p.ColorPatch = nil
+	if width == 0 {
+		return nil
+	}
+	patch := &MapColorPatch{Width: width}
+	if patch.Height, err = buf.ReadUint8(); err != nil {
+		return err
+	}
+	if patch.StartX, err = buf.ReadUint8(); err != nil {
+		return err
+	}
+	if patch.StartY, err = buf.ReadUint8(); err != nil {
+		return err
+	}
+	if patch.Colors, err = buf.ReadByteArray(128 * 128); err != nil {
+		return err
+	}
+	p.ColorPatch = patch
+	return nil
 }
 
 func (p *S2CMapItemData) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteVarInt(p.MapId); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
-}
-
-// S2CMerchantOffers represents "Merchant Offers".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Merchant_Offers
-type S2CMerchantOffers struct {
-	WindowId ns.VarInt
-	Data     ns.ByteArray
-}
-
-func (p *S2CMerchantOffers) Read(buf *ns.PacketBuffer) error {
-	var err error
-	if p.WindowId, err = buf.ReadVarInt(); err != nil {
+	if err := buf.WriteInt8(p.Scale); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
-}
-
-func (p *S2CMerchantOffers) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteVarInt(p.WindowId); err != nil {
+	if err := buf.WriteBool(p.Locked); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
-}
-
-// S2CMoveEntityPos represents "Update Entity Position".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Entity_Position
-type S2CMoveEntityPos struct {
-	EntityId ns.VarInt
-	DeltaX   ns.Int16
-	DeltaY   ns.Int16
-	DeltaZ   ns.Int16
-	OnGround ns.Boolean
+	if err := p.Decorations.EncodeWith(buf, func(b *ns.PacketBuffer, decorations ns.PrefixedArray[MapDecoration]) error {
+		return decorations.EncodeWith(b, func(b *ns.PacketBuffer, d MapDecoration) error {
+			return d.Write(b)
+		})
+	}); err != nil {
+		return err
+	}
+	if p.ColorPatch == nil || p.ColorPatch.Width == 0 {
+		return buf.WriteUint8(0)
+	}
+	if err := buf.WriteUint8(p.ColorPatch.Width); err != nil {
+		return err
+	}
+	if err := buf.WriteUint8(p.ColorPatch.Height); err != nil {
+		return err
+	}
+	if err := buf.WriteUint8(p.ColorPatch.StartX); err != nil {
+		return err
+	}
+	if err := buf.WriteUint8(p.ColorPatch.StartY); err != nil {
+		return err
+	}
+	return buf.WriteByteArray(p.ColorPatch.Colors)
 }
 
-func (p *S2CMoveEntityPos) Read(buf *ns.PacketBuffer) error {
+func (d *MapDecoration) Read(buf *ns.PacketBuffer) error {
 	var err error
-	if p.EntityId, err = buf.ReadVarInt(); err != nil {
+	if d.Type, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	if p.DeltaX, err = buf.ReadInt16(); err != nil {
+	if d.X, err = buf.ReadInt8(); err != nil {
 		return err
 	}
-	if p.DeltaY, err = buf.ReadInt16(); err != nil {
+	if d.Y, err = buf.ReadInt8(); err != nil {
 		return err
 	}
-	if p.DeltaZ, err = buf.ReadInt16(); err != nil {
+	if d.Rotation, err = buf.ReadInt8(); err != nil {
 		return err
 	}
-	p.OnGround, err = buf.ReadBool()
-	return err
+	return d.DisplayName.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.TextComponent, error) {
+		return b.ReadTextComponent()
+	})
 }
 
-func (p *S2CMoveEntityPos) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteVarInt(p.EntityId); err != nil {
+func (d *MapDecoration) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(d.Type); err != nil {
 		return err
 	}
-	if err := buf.WriteInt16(p.DeltaX); err != nil {
+	if err := buf.WriteInt8(d.X); err != nil {
 		return err
 	}
-	if err := buf.WriteInt16(p.DeltaY); err != nil {
+	if err := buf.WriteInt8(d.Y); err != nil {
 		return err
 	}
-	if err := buf.WriteInt16(p.DeltaZ); err != nil {
+	if err := buf.WriteInt8(d.Rotation); err != nil {
 		return err
 	}
-	return buf.WriteBool(p.OnGround)
+	return d.DisplayName.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.TextComponent) error {
+		return b.WriteTextComponent(v)
+	})
 }
 
-// S2CMoveEntityPosRot represents "Update Entity Position and Rotation".
+// S2CMerchantOffers represents "Merchant Offers".
 //
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Entity_Position_And_Rotation
-type S2CMoveEntityPosRot struct {
-	EntityId ns.VarInt
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Merchant_Offers
+type S2CMerchantOffers struct {
+	WindowId      ns.VarInt
+	Offers        ns.PrefixedArray[MerchantOffer]
+	VillagerLevel ns.VarInt
+	Experience    ns.VarInt
+	// IsRegularVillager shows the level progress bar (false for wandering traders).
+	IsRegularVillager ns.Boolean
+	CanRestock        ns.Boolean
+}
+
+// MerchantOffer is a single trade of a villager or wandering trader.
+type MerchantOffer struct {
+	CostA      ItemCost
+	Result     *items.ItemStack
+	CostB      ns.PrefixedOptional[ItemCost]
+	OutOfStock ns.Boolean
+	Uses       ns.Int32
+	MaxUses    ns.Int32
+	Xp         ns.Int32
+	// SpecialPrice is added to the first cost (negative for discounts, e.g. from reputation).
+	SpecialPrice    ns.Int32
+	PriceMultiplier ns.Float32
+	Demand          ns.Int32
+}
+
+// ItemCost is an item that must be paid for a trade.
+type ItemCost struct {
+	Item  ns.VarInt
+	Count ns.VarInt
+	// Components are the exact components the paid item must have, or nil for none.
+	// Only present components (see items.Components.SetPresent) are part of the predicate.
+	Components *items.Components
+}
+
+func (p *S2CMerchantOffers) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.WindowId, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if err = p.Offers.DecodeWith(buf, func(b *ns.PacketBuffer) (MerchantOffer, error) {
+		var o MerchantOffer
+		err := o.Read(b)
+		return o, err
+	}); err != nil {
+		return err
+	}
+	if p.VillagerLevel, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if p.Experience, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if p.IsRegularVillager, err = buf.ReadBool(); err != nil {
+		return err
+	}
+	p.CanRestock, err = buf.ReadBool()
+	return err
+}
+
+func (p *S2CMerchantOffers) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(p.WindowId); err != nil {
+		return err
+	}
+	if err := p.Offers.EncodeWith(buf, func(b *ns.PacketBuffer, o MerchantOffer) error {
+		return o.Write(b)
+	}); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(p.VillagerLevel); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(p.Experience); err != nil {
+		return err
+	}
+	if err := buf.WriteBool(p.IsRegularVillager); err != nil {
+		return err
+	}
+	return buf.WriteBool(p.CanRestock)
+}
+
+func (o *MerchantOffer) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if err = o.CostA.Read(buf); err != nil {
+		return err
+	}
+	if o.Result, err = items.ReadSlot(buf); err != nil {
+		return err
+	}
+	if err = o.CostB.DecodeWith(buf, func(b *ns.PacketBuffer) (ItemCost, error) {
+		var c ItemCost
+		err := c.Read(b)
+		return c, err
+	}); err != nil {
+		return err
+	}
+	if o.OutOfStock, err = buf.ReadBool(); err != nil {
+		return err
+	}
+	if o.Uses, err = buf.ReadInt32(); err != nil {
+		return err
+	}
+	if o.MaxUses, err = buf.ReadInt32(); err != nil {
+		return err
+	}
+	if o.Xp, err = buf.ReadInt32(); err != nil {
+		return err
+	}
+	if o.SpecialPrice, err = buf.ReadInt32(); err != nil {
+		return err
+	}
+	if o.PriceMultiplier, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	o.Demand, err = buf.ReadInt32()
+	return err
+}
+
+func (o *MerchantOffer) Write(buf *ns.PacketBuffer) error {
+	if err := o.CostA.Write(buf); err != nil {
+		return err
+	}
+	result := o.Result
+	if result == nil {
+		result = items.EmptyStack()
+	}
+	if err := result.WriteSlot(buf); err != nil {
+		return err
+	}
+	if err := o.CostB.EncodeWith(buf, func(b *ns.PacketBuffer, c ItemCost) error {
+		return c.Write(b)
+	}); err != nil {
+		return err
+	}
+	if err := buf.WriteBool(o.OutOfStock); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(o.Uses); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(o.MaxUses); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(o.Xp); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(o.SpecialPrice); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(o.PriceMultiplier); err != nil {
+		return err
+	}
+	return buf.WriteInt32(o.Demand)
+}
+
+// CostACount returns the number of CostA items actually charged: the base count
+// raised by demand and adjusted by the special price, clamped to 1..max stack size.
+// This is the vanilla MerchantOffer.getModifiedCostCount formula.
+func (o *MerchantOffer) CostACount() int32 {
+	count := int32(o.CostA.Count)
+	demandDiff := max(0, int32(math.Floor(float64(float32(count*int32(o.Demand))*float32(o.PriceMultiplier)))))
+	maxStackSize := int32(64)
+	if defaults := items.DefaultComponents(int32(o.CostA.Item)); defaults != nil && defaults.MaxStackSize > 0 {
+		maxStackSize = defaults.MaxStackSize
+	}
+	return min(max(count+demandDiff+int32(o.SpecialPrice), 1), maxStackSize)
+}
+
+// EffectiveCostA returns the first cost as an item stack with the count actually charged.
+func (o *MerchantOffer) EffectiveCostA() (*items.ItemStack, error) {
+	stack, err := o.CostA.Stack()
+	if err != nil {
+		return nil, err
+	}
+	stack.Count = o.CostACount()
+	return stack, nil
+}
+
+// EmeraldPrice returns the total number of emeralds charged by the offer,
+// counting both costs. Demand and special price only affect the first cost.
+func (o *MerchantOffer) EmeraldPrice() int32 {
+	emerald := items.ItemID("minecraft:emerald")
+	var price int32
+	if int32(o.CostA.Item) == emerald {
+		price += o.CostACount()
+	}
+	if costB, ok := o.CostB.Get(); ok && int32(costB.Item) == emerald {
+		price += int32(costB.Count)
+	}
+	return price
+}
+
+// Stack returns the cost as an item stack with the item's default components
+// and the predicate components applied.
+func (c *ItemCost) Stack() (*items.ItemStack, error) {
+	stack := items.NewStack(int32(c.Item), int32(c.Count))
+	if c.Components == nil {
+		return stack, nil
+	}
+	for id := int32(0); id <= items.MaxComponentID; id++ {
+		codec := items.GetCodec(id)
+		if codec == nil || !c.Components.HasComponent(id) {
+			continue
+		}
+		data, err := codec.Encode(c.Components)
+		if err != nil {
+			return nil, fmt.Errorf("component %d: %w", id, err)
+		}
+		if err := codec.Apply(stack.Components, data); err != nil {
+			return nil, fmt.Errorf("component %d: %w", id, err)
+		}
+	}
+	return stack, nil
+}
+
+// Matches reports whether the stack is the cost item with all predicate components.
+// The count is not checked.
+func (c *ItemCost) Matches(stack *items.ItemStack) bool {
+	if stack.IsEmpty() || stack.ID != int32(c.Item) {
+		return false
+	}
+	if c.Components == nil {
+		return true
+	}
+	for id := int32(0); id <= items.MaxComponentID; id++ {
+		codec := items.GetCodec(id)
+		if codec == nil || !c.Components.HasComponent(id) {
+			continue
+		}
+		if stack.Components == nil {
+			return false
+		}
+		if differs, _ := codec.Differs(stack.Components, c.Components); differs {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *ItemCost) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if c.Item, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if c.Count, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	c.Components = nil
+	if count == 0 {
+		return nil
+	}
+	c.Components = &items.Components{}
+	for range count {
+		id, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		codec := items.GetCodec(int32(id))
+		if codec == nil {
+			return fmt.Errorf("unknown component ID %d", id)
+		}
+		data, err := codec.DecodeWire(buf)
+		if err != nil {
+			return fmt.Errorf("component %d: %w", id, err)
+		}
+		if err := codec.Apply(c.Components, data); err != nil {
+			return fmt.Errorf("component %d: %w", id, err)
+		}
+		c.Components.SetPresent(int32(id))
+	}
+	return nil
+}
+
+func (c *ItemCost) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(c.Item); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(c.Count); err != nil {
+		return err
+	}
+	var ids []int32
+	if c.Components != nil {
+		for id := int32(0); id <= items.MaxComponentID; id++ {
+			if items.GetCodec(id) != nil && c.Components.HasComponent(id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if err := buf.WriteVarInt(ns.VarInt(len(ids))); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		data, err := items.GetCodec(id).Encode(c.Components)
+		if err != nil {
+			return fmt.Errorf("component %d: %w", id, err)
+		}
+		if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+			return err
+		}
+		if err := buf.WriteFixedByteArray(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// S2CMoveEntityPos represents "Update Entity Position".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Entity_Position
+type S2CMoveEntityPos struct {
+	EntityId ns.VarInt
+	DeltaX   ns.Int16
+	DeltaY   ns.Int16
+	DeltaZ   ns.Int16
+	OnGround ns.Boolean
+}
+
+func (p *S2CMoveEntityPos) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.EntityId, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if p.DeltaX, err = buf.ReadInt16(); err != nil {
+		return err
+	}
+	if p.DeltaY, err = buf.ReadInt16(); err != nil {
+		return err
+	}
+	if p.DeltaZ, err = buf.ReadInt16(); err != nil {
+		return err
+	}
+	p.OnGround, err = buf.ReadBool()
+	return err
+}
+
+func (p *S2CMoveEntityPos) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(p.EntityId); err != nil {
+		return err
+	}
+	if err := buf.WriteInt16(p.DeltaX); err != nil {
+		return err
+	}
+	if err := buf.WriteInt16(p.DeltaY); err != nil {
+		return err
+	}
+	if err := buf.WriteInt16(p.DeltaZ); err != nil {
+		return err
+	}
+	return buf.WriteBool(p.OnGround)
+}
+
+// S2CMoveEntityPosRot represents "Update Entity Position and Rotation".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Entity_Position_And_Rotation
+type S2CMoveEntityPosRot struct {
+	EntityId ns.VarInt
 	DeltaX   ns.Int16
 	DeltaY   ns.Int16
 	DeltaZ   ns.Int16
@@ -2171,7 +2789,7 @@ func (p *S2CPongResponsePlay) Write(buf *ns.PacketBuffer) error {
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Place_Ghost_Recipe
 type S2CPlaceGhostRecipe struct {
 	WindowId      ns.VarInt
-	RecipeDisplay ns.ByteArray
+	RecipeDisplay RecipeDisplay
 }
 
 func (p *S2CPlaceGhostRecipe) Read(buf *ns.PacketBuffer) error {
@@ -2179,7 +2797,7 @@ func (p *S2CPlaceGhostRecipe) Read(buf *ns.PacketBuffer) error {
 	if p.WindowId, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.RecipeDisplay, err = buf.ReadByteArray(1048576)
+	p.RecipeDisplay, err = ReadRecipeDisplay(buf)
 	return err
 }
 
@@ -2187,60 +2805,698 @@ func (p *S2CPlaceGhostRecipe) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteVarInt(p.WindowId); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.RecipeDisplay)
+	return WriteRecipeDisplay(buf, p.RecipeDisplay)
+}
+
+// SlotDisplay describes what the client shows in a recipe slot.
+// It is one of the *SlotDisplay types of the minecraft:slot_display registry.
+type SlotDisplay interface {
+	// SlotDisplayType returns the registry entry, e.g. "minecraft:item".
+	SlotDisplayType() string
+	Read(buf *ns.PacketBuffer) error
+	Write(buf *ns.PacketBuffer) error
+}
+
+// newSlotDisplay returns a zero-valued slot display of the given type, or nil if unknown.
+func newSlotDisplay(displayType string) SlotDisplay {
+	switch displayType {
+	case "minecraft:empty":
+		return &EmptySlotDisplay{}
+	case "minecraft:any_fuel":
+		return &AnyFuelSlotDisplay{}
+	case "minecraft:with_any_potion":
+		return &WithAnyPotionSlotDisplay{}
+	case "minecraft:only_with_component":
+		return &OnlyWithComponentSlotDisplay{}
+	case "minecraft:item":
+		return &ItemSlotDisplay{}
+	case "minecraft:item_stack":
+		return &ItemStackSlotDisplay{}
+	case "minecraft:tag":
+		return &TagSlotDisplay{}
+	case "minecraft:dyed":
+		return &DyedSlotDisplay{}
+	case "minecraft:smithing_trim":
+		return &SmithingTrimSlotDisplay{}
+	case "minecraft:with_remainder":
+		return &WithRemainderSlotDisplay{}
+	case "minecraft:composite":
+		return &CompositeSlotDisplay{}
+	}
+	return nil
 }
 
-// S2CPlayerAbilities represents "Player Abilities (clientbound)".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Player_Abilities_(Clientbound)
-type S2CPlayerAbilities struct {
-	Flags               ns.Int8
-	FlyingSpeed         ns.Float32
-	FieldOfViewModifier ns.Float32
+// limits of nested slot displays; vanilla recipes stay far below them
+const (
+	maxSlotDisplayDepth = 16
+	maxSlotDisplays     = 4096
+)
+
+// nestedSlotDisplay is a slot display that contains other slot displays.
+type nestedSlotDisplay interface {
+	read(buf *ns.PacketBuffer, depth int) error
 }
 
-func (p *S2CPlayerAbilities) Read(buf *ns.PacketBuffer) error {
-	var err error
-	if p.Flags, err = buf.ReadInt8(); err != nil {
-		return err
+// ReadSlotDisplay reads a type-prefixed slot display.
+func ReadSlotDisplay(buf *ns.PacketBuffer) (SlotDisplay, error) {
+	return readSlotDisplay(buf, 0)
+}
+
+func readSlotDisplay(buf *ns.PacketBuffer, depth int) (SlotDisplay, error) {
+	if depth > maxSlotDisplayDepth {
+		return nil, fmt.Errorf("slot displays nested deeper than %d", maxSlotDisplayDepth)
 	}
-	if p.FlyingSpeed, err = buf.ReadFloat32(); err != nil {
-		return err
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return nil, err
 	}
-	p.FieldOfViewModifier, err = buf.ReadFloat32()
-	return err
+	d := newSlotDisplay(registries.SlotDisplay.ByID(int32(id)))
+	if d == nil {
+		return nil, fmt.Errorf("unknown slot display type %d", id)
+	}
+	if n, ok := d.(nestedSlotDisplay); ok {
+		return d, n.read(buf, depth)
+	}
+	return d, d.Read(buf)
 }
 
-func (p *S2CPlayerAbilities) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteInt8(p.Flags); err != nil {
-		return err
+// WriteSlotDisplay writes a type-prefixed slot display. A nil display is written as empty.
+func WriteSlotDisplay(buf *ns.PacketBuffer, d SlotDisplay) error {
+	if d == nil {
+		d = &EmptySlotDisplay{}
 	}
-	if err := buf.WriteFloat32(p.FlyingSpeed); err != nil {
+	id := registries.SlotDisplay.Get(d.SlotDisplayType())
+	if id < 0 {
+		return fmt.Errorf("unknown slot display type %q", d.SlotDisplayType())
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
 		return err
 	}
-	return buf.WriteFloat32(p.FieldOfViewModifier)
+	return d.Write(buf)
 }
 
-// S2CPlayerChat represents "Player Chat Message".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Player_Chat_Message
-type S2CPlayerChat struct {
-	GlobalIndex     ns.VarInt
-	Sender          ns.UUID
-	Index           ns.VarInt
-	Signature       ns.PrefixedOptional[MessageSignature]
-	Body            SignedMessageBody
-	UnsignedContent ns.PrefixedOptional[ns.TextComponent]
-	FilterMask      FilterMask
-	ChatType        ChatTypeBound
+func readSlotDisplays(buf *ns.PacketBuffer, depth int) ([]SlotDisplay, error) {
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 || count > maxSlotDisplays {
+		return nil, fmt.Errorf("invalid slot display count %d", count)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	displays := make([]SlotDisplay, count)
+	for i := range displays {
+		if displays[i], err = readSlotDisplay(buf, depth); err != nil {
+			return nil, err
+		}
+	}
+	return displays, nil
 }
 
-// MessageSignature is a 256-byte cryptographic signature for chat messages.
-type MessageSignature [256]byte
-
-// SignedMessageBody contains the signed content of a chat message.
-type SignedMessageBody struct {
-	Content   ns.String
+func writeSlotDisplays(buf *ns.PacketBuffer, displays []SlotDisplay) error {
+	if err := buf.WriteVarInt(ns.VarInt(len(displays))); err != nil {
+		return err
+	}
+	for _, d := range displays {
+		if err := WriteSlotDisplay(buf, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EmptySlotDisplay is an empty slot.
+type EmptySlotDisplay struct{}
+
+func (d *EmptySlotDisplay) SlotDisplayType() string      { return "minecraft:empty" }
+func (d *EmptySlotDisplay) Read(*ns.PacketBuffer) error  { return nil }
+func (d *EmptySlotDisplay) Write(*ns.PacketBuffer) error { return nil }
+
+// AnyFuelSlotDisplay cycles through all furnace fuels.
+type AnyFuelSlotDisplay struct{}
+
+func (d *AnyFuelSlotDisplay) SlotDisplayType() string      { return "minecraft:any_fuel" }
+func (d *AnyFuelSlotDisplay) Read(*ns.PacketBuffer) error  { return nil }
+func (d *AnyFuelSlotDisplay) Write(*ns.PacketBuffer) error { return nil }
+
+// WithAnyPotionSlotDisplay cycles through the display with every potion applied.
+type WithAnyPotionSlotDisplay struct {
+	Display SlotDisplay
+}
+
+func (d *WithAnyPotionSlotDisplay) SlotDisplayType() string { return "minecraft:with_any_potion" }
+
+func (d *WithAnyPotionSlotDisplay) Read(buf *ns.PacketBuffer) error { return d.read(buf, 0) }
+
+func (d *WithAnyPotionSlotDisplay) read(buf *ns.PacketBuffer, depth int) error {
+	var err error
+	d.Display, err = readSlotDisplay(buf, depth+1)
+	return err
+}
+
+func (d *WithAnyPotionSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	return WriteSlotDisplay(buf, d.Display)
+}
+
+// OnlyWithComponentSlotDisplay shows the items of a display that have a data component.
+type OnlyWithComponentSlotDisplay struct {
+	Display SlotDisplay
+	// Component is the minecraft:data_component_type registry ID.
+	Component ns.VarInt
+}
+
+func (d *OnlyWithComponentSlotDisplay) SlotDisplayType() string {
+	return "minecraft:only_with_component"
+}
+
+func (d *OnlyWithComponentSlotDisplay) Read(buf *ns.PacketBuffer) error { return d.read(buf, 0) }
+
+func (d *OnlyWithComponentSlotDisplay) read(buf *ns.PacketBuffer, depth int) error {
+	var err error
+	if d.Display, err = readSlotDisplay(buf, depth+1); err != nil {
+		return err
+	}
+	d.Component, err = buf.ReadVarInt()
+	return err
+}
+
+func (d *OnlyWithComponentSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Display); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(d.Component)
+}
+
+// ItemSlotDisplay shows a single item.
+type ItemSlotDisplay struct {
+	// Item is the item protocol ID.
+	Item ns.VarInt
+}
+
+func (d *ItemSlotDisplay) SlotDisplayType() string { return "minecraft:item" }
+
+func (d *ItemSlotDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	d.Item, err = buf.ReadVarInt()
+	return err
+}
+
+func (d *ItemSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(d.Item)
+}
+
+// ItemStackSlotDisplay shows an item stack with count and components.
+type ItemStackSlotDisplay struct {
+	Stack *items.ItemStack
+}
+
+func (d *ItemStackSlotDisplay) SlotDisplayType() string { return "minecraft:item_stack" }
+
+func (d *ItemStackSlotDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	d.Stack, err = items.ReadSlot(buf)
+	return err
+}
+
+func (d *ItemStackSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	stack := d.Stack
+	if stack == nil {
+		stack = items.EmptyStack()
+	}
+	return stack.WriteSlot(buf)
+}
+
+// TagSlotDisplay cycles through the items of an item tag.
+type TagSlotDisplay struct {
+	// Tag is the item tag, without the leading '#'.
+	Tag ns.Identifier
+}
+
+func (d *TagSlotDisplay) SlotDisplayType() string { return "minecraft:tag" }
+
+func (d *TagSlotDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	d.Tag, err = buf.ReadIdentifier()
+	return err
+}
+
+func (d *TagSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteIdentifier(d.Tag)
+}
+
+// DyedSlotDisplay shows the target display dyed with the dye display.
+type DyedSlotDisplay struct {
+	Dye    SlotDisplay
+	Target SlotDisplay
+}
+
+func (d *DyedSlotDisplay) SlotDisplayType() string { return "minecraft:dyed" }
+
+func (d *DyedSlotDisplay) Read(buf *ns.PacketBuffer) error { return d.read(buf, 0) }
+
+func (d *DyedSlotDisplay) read(buf *ns.PacketBuffer, depth int) error {
+	var err error
+	if d.Dye, err = readSlotDisplay(buf, depth+1); err != nil {
+		return err
+	}
+	d.Target, err = readSlotDisplay(buf, depth+1)
+	return err
+}
+
+func (d *DyedSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Dye); err != nil {
+		return err
+	}
+	return WriteSlotDisplay(buf, d.Target)
+}
+
+// TrimPattern is an inline minecraft:trim_pattern registry entry.
+type TrimPattern struct {
+	AssetId      ns.Identifier
+	TemplateItem ns.VarInt
+	Description  ns.TextComponent
+	Decal        ns.Boolean
+}
+
+// SmithingTrimSlotDisplay shows the result of applying a trim to armor.
+type SmithingTrimSlotDisplay struct {
+	Base     SlotDisplay
+	Material SlotDisplay
+	Pattern  ns.IDOrX[TrimPattern]
+}
+
+func (d *SmithingTrimSlotDisplay) SlotDisplayType() string { return "minecraft:smithing_trim" }
+
+func (d *SmithingTrimSlotDisplay) Read(buf *ns.PacketBuffer) error { return d.read(buf, 0) }
+
+func (d *SmithingTrimSlotDisplay) read(buf *ns.PacketBuffer, depth int) error {
+	var err error
+	if d.Base, err = readSlotDisplay(buf, depth+1); err != nil {
+		return err
+	}
+	if d.Material, err = readSlotDisplay(buf, depth+1); err != nil {
+		return err
+	}
+	return d.Pattern.DecodeWith(buf, func(b *ns.PacketBuffer) (TrimPattern, error) {
+		var tp TrimPattern
+		var err error
+		if tp.AssetId, err = b.ReadIdentifier(); err != nil {
+			return tp, err
+		}
+		if tp.TemplateItem, err = b.ReadVarInt(); err != nil {
+			return tp, err
+		}
+		if tp.Description, err = b.ReadTextComponent(); err != nil {
+			return tp, err
+		}
+		tp.Decal, err = b.ReadBool()
+		return tp, err
+	})
+}
+
+func (d *SmithingTrimSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Base); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Material); err != nil {
+		return err
+	}
+	return d.Pattern.EncodeWith(buf, func(b *ns.PacketBuffer, tp TrimPattern) error {
+		if err := b.WriteIdentifier(tp.AssetId); err != nil {
+			return err
+		}
+		if err := b.WriteVarInt(tp.TemplateItem); err != nil {
+			return err
+		}
+		if err := b.WriteTextComponent(tp.Description); err != nil {
+			return err
+		}
+		return b.WriteBool(tp.Decal)
+	})
+}
+
+// WithRemainderSlotDisplay shows an input together with the item left over after crafting.
+type WithRemainderSlotDisplay struct {
+	Input     SlotDisplay
+	Remainder SlotDisplay
+}
+
+func (d *WithRemainderSlotDisplay) SlotDisplayType() string { return "minecraft:with_remainder" }
+
+func (d *WithRemainderSlotDisplay) Read(buf *ns.PacketBuffer) error { return d.read(buf, 0) }
+
+func (d *WithRemainderSlotDisplay) read(buf *ns.PacketBuffer, depth int) error {
+	var err error
+	if d.Input, err = readSlotDisplay(buf, depth+1); err != nil {
+		return err
+	}
+	d.Remainder, err = readSlotDisplay(buf, depth+1)
+	return err
+}
+
+func (d *WithRemainderSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Input); err != nil {
+		return err
+	}
+	return WriteSlotDisplay(buf, d.Remainder)
+}
+
+// CompositeSlotDisplay cycles through several displays.
+type CompositeSlotDisplay struct {
+	Contents []SlotDisplay
+}
+
+func (d *CompositeSlotDisplay) SlotDisplayType() string { return "minecraft:composite" }
+
+func (d *CompositeSlotDisplay) Read(buf *ns.PacketBuffer) error { return d.read(buf, 0) }
+
+func (d *CompositeSlotDisplay) read(buf *ns.PacketBuffer, depth int) error {
+	var err error
+	d.Contents, err = readSlotDisplays(buf, depth+1)
+	return err
+}
+
+func (d *CompositeSlotDisplay) Write(buf *ns.PacketBuffer) error {
+	return writeSlotDisplays(buf, d.Contents)
+}
+
+// RecipeDisplay describes how the client shows a recipe.
+// It is one of the *RecipeDisplay types of the minecraft:recipe_display registry.
+type RecipeDisplay interface {
+	// RecipeDisplayType returns the registry entry, e.g. "minecraft:crafting_shaped".
+	RecipeDisplayType() string
+	Read(buf *ns.PacketBuffer) error
+	Write(buf *ns.PacketBuffer) error
+}
+
+// newRecipeDisplay returns a zero-valued recipe display of the given type, or nil if unknown.
+func newRecipeDisplay(displayType string) RecipeDisplay {
+	switch displayType {
+	case "minecraft:crafting_shapeless":
+		return &ShapelessCraftingRecipeDisplay{}
+	case "minecraft:crafting_shaped":
+		return &ShapedCraftingRecipeDisplay{}
+	case "minecraft:furnace":
+		return &FurnaceRecipeDisplay{}
+	case "minecraft:stonecutter":
+		return &StonecutterRecipeDisplay{}
+	case "minecraft:smithing":
+		return &SmithingRecipeDisplay{}
+	}
+	return nil
+}
+
+// ReadRecipeDisplay reads a type-prefixed recipe display.
+func ReadRecipeDisplay(buf *ns.PacketBuffer) (RecipeDisplay, error) {
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return nil, err
+	}
+	d := newRecipeDisplay(registries.RecipeDisplay.ByID(int32(id)))
+	if d == nil {
+		return nil, fmt.Errorf("unknown recipe display type %d", id)
+	}
+	return d, d.Read(buf)
+}
+
+// WriteRecipeDisplay writes a type-prefixed recipe display.
+func WriteRecipeDisplay(buf *ns.PacketBuffer, d RecipeDisplay) error {
+	if d == nil {
+		return fmt.Errorf("nil recipe display")
+	}
+	id := registries.RecipeDisplay.Get(d.RecipeDisplayType())
+	if id < 0 {
+		return fmt.Errorf("unknown recipe display type %q", d.RecipeDisplayType())
+	}
+	if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+		return err
+	}
+	return d.Write(buf)
+}
+
+// ShapelessCraftingRecipeDisplay is a shapeless crafting recipe.
+type ShapelessCraftingRecipeDisplay struct {
+	Ingredients     []SlotDisplay
+	Result          SlotDisplay
+	CraftingStation SlotDisplay
+}
+
+func (d *ShapelessCraftingRecipeDisplay) RecipeDisplayType() string {
+	return "minecraft:crafting_shapeless"
+}
+
+func (d *ShapelessCraftingRecipeDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if d.Ingredients, err = readSlotDisplays(buf, 0); err != nil {
+		return err
+	}
+	if d.Result, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	d.CraftingStation, err = ReadSlotDisplay(buf)
+	return err
+}
+
+func (d *ShapelessCraftingRecipeDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := writeSlotDisplays(buf, d.Ingredients); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Result); err != nil {
+		return err
+	}
+	return WriteSlotDisplay(buf, d.CraftingStation)
+}
+
+// ShapedCraftingRecipeDisplay is a shaped crafting recipe.
+// Ingredients has Width*Height entries in row-major order.
+type ShapedCraftingRecipeDisplay struct {
+	Width           ns.VarInt
+	Height          ns.VarInt
+	Ingredients     []SlotDisplay
+	Result          SlotDisplay
+	CraftingStation SlotDisplay
+}
+
+func (d *ShapedCraftingRecipeDisplay) RecipeDisplayType() string {
+	return "minecraft:crafting_shaped"
+}
+
+func (d *ShapedCraftingRecipeDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if d.Width, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if d.Height, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if d.Ingredients, err = readSlotDisplays(buf, 0); err != nil {
+		return err
+	}
+	if d.Result, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	d.CraftingStation, err = ReadSlotDisplay(buf)
+	return err
+}
+
+func (d *ShapedCraftingRecipeDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(d.Width); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(d.Height); err != nil {
+		return err
+	}
+	if err := writeSlotDisplays(buf, d.Ingredients); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Result); err != nil {
+		return err
+	}
+	return WriteSlotDisplay(buf, d.CraftingStation)
+}
+
+// FurnaceRecipeDisplay is a smelting, blasting, smoking or campfire recipe.
+type FurnaceRecipeDisplay struct {
+	Ingredient      SlotDisplay
+	Fuel            SlotDisplay
+	Result          SlotDisplay
+	CraftingStation SlotDisplay
+	// Duration is the cooking time in ticks.
+	Duration   ns.VarInt
+	Experience ns.Float32
+}
+
+func (d *FurnaceRecipeDisplay) RecipeDisplayType() string { return "minecraft:furnace" }
+
+func (d *FurnaceRecipeDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if d.Ingredient, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Fuel, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Result, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.CraftingStation, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Duration, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	d.Experience, err = buf.ReadFloat32()
+	return err
+}
+
+func (d *FurnaceRecipeDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Ingredient); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Fuel); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Result); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.CraftingStation); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(d.Duration); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(d.Experience)
+}
+
+// StonecutterRecipeDisplay is a stonecutting recipe.
+type StonecutterRecipeDisplay struct {
+	Input           SlotDisplay
+	Result          SlotDisplay
+	CraftingStation SlotDisplay
+}
+
+func (d *StonecutterRecipeDisplay) RecipeDisplayType() string { return "minecraft:stonecutter" }
+
+func (d *StonecutterRecipeDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if d.Input, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Result, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	d.CraftingStation, err = ReadSlotDisplay(buf)
+	return err
+}
+
+func (d *StonecutterRecipeDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Input); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Result); err != nil {
+		return err
+	}
+	return WriteSlotDisplay(buf, d.CraftingStation)
+}
+
+// SmithingRecipeDisplay is a smithing transform or trim recipe.
+type SmithingRecipeDisplay struct {
+	Template        SlotDisplay
+	Base            SlotDisplay
+	Addition        SlotDisplay
+	Result          SlotDisplay
+	CraftingStation SlotDisplay
+}
+
+func (d *SmithingRecipeDisplay) RecipeDisplayType() string { return "minecraft:smithing" }
+
+func (d *SmithingRecipeDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if d.Template, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Base, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Addition, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	if d.Result, err = ReadSlotDisplay(buf); err != nil {
+		return err
+	}
+	d.CraftingStation, err = ReadSlotDisplay(buf)
+	return err
+}
+
+func (d *SmithingRecipeDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := WriteSlotDisplay(buf, d.Template); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Base); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Addition); err != nil {
+		return err
+	}
+	if err := WriteSlotDisplay(buf, d.Result); err != nil {
+		return err
+	}
+	return WriteSlotDisplay(buf, d.CraftingStation)
+}
+
+// S2CPlayerAbilities represents "Player Abilities (clientbound)".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Player_Abilities_(Clientbound)
+type S2CPlayerAbilities struct {
+	Flags               ns.Int8
+	FlyingSpeed         ns.Float32
+	FieldOfViewModifier ns.Float32
+}
+
+func (p *S2CPlayerAbilities) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.Flags, err = buf.ReadInt8(); err != nil {
+		return err
+	}
+	if p.FlyingSpeed, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	p.FieldOfViewModifier, err = buf.ReadFloat32()
+	return err
+}
+
+func (p *S2CPlayerAbilities) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt8(p.Flags); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(p.FlyingSpeed); err != nil {
+		return err
+	}
+	return buf.WriteFloat32(p.FieldOfViewModifier)
+}
+
+// S2CPlayerChat represents "Player Chat Message".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Player_Chat_Message
+type S2CPlayerChat struct {
+	GlobalIndex     ns.VarInt
+	Sender          ns.UUID
+	Index           ns.VarInt
+	Signature       ns.PrefixedOptional[MessageSignature]
+	Body            SignedMessageBody
+	UnsignedContent ns.PrefixedOptional[ns.TextComponent]
+	FilterMask      FilterMask
+	ChatType        ChatTypeBound
+}
+
+// MessageSignature is a 256-byte cryptographic signature for chat messages.
+type MessageSignature [256]byte
+
+// SignedMessageBody contains the signed content of a chat message.
+type SignedMessageBody struct {
+	Content   ns.String
 	Timestamp ns.Int64 // epoch milliseconds
 	Salt      ns.Int64
 	LastSeen  LastSeenMessagesPacked
@@ -2274,13 +3530,135 @@ const (
 
 // ChatTypeBound contains the chat type and sender information.
 type ChatTypeBound struct {
-	// Registry ID. In vanilla: 1 = normal chat, 3 = whisper, 5 = /say, 2 = /me, ...
-	// TODO: accumulate the registry data in client store and reference in higher level funcs
+	// ChatType is the minecraft:chat_type registry ID, or -1 if the chat type is
+	// sent inline in Direct. Use chat.Decorator to resolve it.
 	ChatType   ns.VarInt
+	Direct     *ChatType
 	Name       ns.TextComponent                      // sender's display name
 	TargetName ns.PrefixedOptional[ns.TextComponent] // target's display name (for whispers)
 }
 
+func (b *ChatTypeBound) Read(buf *ns.PacketBuffer) error {
+	// holder format: VarInt(id + 1) for registry reference, 0 = direct/inline
+	id, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	b.ChatType, b.Direct = id-1, nil
+	if id == 0 {
+		b.Direct = &ChatType{}
+		if err := b.Direct.Read(buf); err != nil {
+			return err
+		}
+	}
+	if b.Name, err = buf.ReadTextComponent(); err != nil {
+		return err
+	}
+	return b.TargetName.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.TextComponent, error) {
+		return b.ReadTextComponent()
+	})
+}
+
+func (b *ChatTypeBound) Write(buf *ns.PacketBuffer) error {
+	if b.Direct != nil {
+		if err := buf.WriteVarInt(0); err != nil {
+			return err
+		}
+		if err := b.Direct.Write(buf); err != nil {
+			return err
+		}
+	} else if err := buf.WriteVarInt(b.ChatType + 1); err != nil {
+		return err
+	}
+	if err := buf.WriteTextComponent(b.Name); err != nil {
+		return err
+	}
+	return b.TargetName.EncodeWith(buf, func(b *ns.PacketBuffer, tc ns.TextComponent) error {
+		return b.WriteTextComponent(tc)
+	})
+}
+
+// ChatType is a minecraft:chat_type definition: how chat messages of the type
+// are shown and narrated. The tags match the registry data format.
+type ChatType struct {
+	Chat      ChatTypeDecoration `nbt:"chat" json:"chat"`
+	Narration ChatTypeDecoration `nbt:"narration" json:"narration"`
+}
+
+func (t *ChatType) Read(buf *ns.PacketBuffer) error {
+	if err := t.Chat.Read(buf); err != nil {
+		return err
+	}
+	return t.Narration.Read(buf)
+}
+
+func (t *ChatType) Write(buf *ns.PacketBuffer) error {
+	if err := t.Chat.Write(buf); err != nil {
+		return err
+	}
+	return t.Narration.Write(buf)
+}
+
+// ChatTypeDecoration is a translation key, the chat parameters that fill in its
+// arguments in order, and the style of the decorated message.
+type ChatTypeDecoration struct {
+	TranslationKey string `nbt:"translation_key" json:"translation_key"`
+	// Parameters are "sender", "target" or "content".
+	Parameters []string `nbt:"parameters" json:"parameters"`
+	// Style holds only style fields, such as the color.
+	Style ns.TextComponent `nbt:"style,omitempty" json:"style,omitempty"`
+}
+
+// chatTypeParameters are the chat type parameters by protocol ID.
+var chatTypeParameters = []string{"sender", "target", "content"}
+
+func (d *ChatTypeDecoration) Read(buf *ns.PacketBuffer) error {
+	key, err := buf.ReadString(32767)
+	if err != nil {
+		return err
+	}
+	d.TranslationKey = string(key)
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return fmt.Errorf("negative chat type parameter count %d", count)
+	}
+	d.Parameters = make([]string, count)
+	for i := range d.Parameters {
+		id, err := buf.ReadVarInt()
+		if err != nil {
+			return err
+		}
+		if id < 0 || int(id) >= len(chatTypeParameters) {
+			return fmt.Errorf("unknown chat type parameter %d", id)
+		}
+		d.Parameters[i] = chatTypeParameters[id]
+	}
+	d.Style, err = buf.ReadTextComponent()
+	return err
+}
+
+func (d *ChatTypeDecoration) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteString(ns.String(d.TranslationKey)); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(len(d.Parameters))); err != nil {
+		return err
+	}
+	for _, param := range d.Parameters {
+		id := slices.Index(chatTypeParameters, param)
+		if id < 0 {
+			return fmt.Errorf("unknown chat type parameter %q", param)
+		}
+		if err := buf.WriteVarInt(ns.VarInt(id)); err != nil {
+			return err
+		}
+	}
+	return buf.WriteTextComponent(d.Style)
+}
+
 func (p *S2CPlayerChat) Read(buf *ns.PacketBuffer) error {
 	var err error
 
@@ -2361,21 +3739,7 @@ func (p *S2CPlayerChat) Read(buf *ns.PacketBuffer) error {
 		p.FilterMask.Mask = bitset
 	}
 
-	// ChatType (holder format: VarInt(id + 1) for registry reference)
-	if p.ChatType.ChatType, err = buf.ReadVarInt(); err != nil {
-		return err
-	}
-	p.ChatType.ChatType-- // convert from wire (id+1) to logical id
-	if p.ChatType.Name, err = buf.ReadTextComponent(); err != nil {
-		return err
-	}
-	if err = p.ChatType.TargetName.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.TextComponent, error) {
-		return b.ReadTextComponent()
-	}); err != nil {
-		return err
-	}
-
-	return nil
+	return p.ChatType.Read(buf)
 }
 
 func (p *S2CPlayerChat) Write(buf *ns.PacketBuffer) error {
@@ -2437,20 +3801,7 @@ func (p *S2CPlayerChat) Write(buf *ns.PacketBuffer) error {
 		}
 	}
 
-	// ChatType (holder format: VarInt(id + 1) for registry reference, 0 = direct/inline)
-	if err := buf.WriteVarInt(p.ChatType.ChatType + 1); err != nil {
-		return err
-	}
-	if err := buf.WriteTextComponent(p.ChatType.Name); err != nil {
-		return err
-	}
-	if err := p.ChatType.TargetName.EncodeWith(buf, func(b *ns.PacketBuffer, tc ns.TextComponent) error {
-		return b.WriteTextComponent(tc)
-	}); err != nil {
-		return err
-	}
-
-	return nil
+	return p.ChatType.Write(buf)
 }
 
 // GetMessage returns the chat message content.
@@ -2520,34 +3871,259 @@ func (p *S2CPlayerCombatKill) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Player_Info_Remove
 type S2CPlayerInfoRemove struct {
-	Uuids ns.ByteArray
+	Uuids ns.PrefixedArray[ns.UUID]
 }
 
 func (p *S2CPlayerInfoRemove) Read(buf *ns.PacketBuffer) error {
+	return p.Uuids.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.UUID, error) {
+		return b.ReadUUID()
+	})
+}
+
+func (p *S2CPlayerInfoRemove) Write(buf *ns.PacketBuffer) error {
+	return p.Uuids.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.UUID) error {
+		return b.WriteUUID(v)
+	})
+}
+
+// PlayerInfoActions is the EnumSet of actions carried by S2CPlayerInfoUpdate.
+// Bit i is set when the action with ordinal i is present.
+type PlayerInfoActions ns.Uint8
+
+const (
+	PlayerInfoAddPlayer PlayerInfoActions = 1 << iota
+	PlayerInfoInitializeChat
+	PlayerInfoUpdateGameMode
+	PlayerInfoUpdateListed
+	PlayerInfoUpdateLatency
+	PlayerInfoUpdateDisplayName
+	PlayerInfoUpdateListOrder
+	PlayerInfoUpdateHat
+)
+
+// Has returns true if all of the given actions are present.
+func (a PlayerInfoActions) Has(actions PlayerInfoActions) bool {
+	return a&actions == actions
+}
+
+// RemoteChatSession is a player's chat session as relayed by the server
+// (the same data the player sent in C2SChatSessionUpdate).
+type RemoteChatSession struct {
+	SessionId    ns.UUID
+	ExpiresAt    ns.Int64
+	PublicKey    ns.ByteArray
+	KeySignature ns.ByteArray
+}
+
+func (s *RemoteChatSession) Read(buf *ns.PacketBuffer) error {
 	var err error
-	p.Uuids, err = buf.ReadByteArray(1048576)
+	if s.SessionId, err = buf.ReadUUID(); err != nil {
+		return err
+	}
+	if s.ExpiresAt, err = buf.ReadInt64(); err != nil {
+		return err
+	}
+	if s.PublicKey, err = buf.ReadByteArray(512); err != nil {
+		return err
+	}
+	s.KeySignature, err = buf.ReadByteArray(4096)
 	return err
 }
 
-func (p *S2CPlayerInfoRemove) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Uuids)
+func (s *RemoteChatSession) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteUUID(s.SessionId); err != nil {
+		return err
+	}
+	if err := buf.WriteInt64(s.ExpiresAt); err != nil {
+		return err
+	}
+	if err := buf.WriteByteArray(s.PublicKey); err != nil {
+		return err
+	}
+	return buf.WriteByteArray(s.KeySignature)
+}
+
+// PlayerInfoEntry is the per-player data of S2CPlayerInfoUpdate.
+// Only the fields of the packet's actions are read and written.
+type PlayerInfoEntry struct {
+	Uuid ns.UUID
+	// PlayerInfoAddPlayer
+	Name       ns.String
+	Properties ns.PrefixedArray[ns.ProfileProperty]
+	// PlayerInfoInitializeChat
+	ChatSession ns.PrefixedOptional[RemoteChatSession]
+	// PlayerInfoUpdateGameMode
+	GameMode ns.VarInt
+	// PlayerInfoUpdateListed
+	Listed ns.Boolean
+	// PlayerInfoUpdateLatency (in milliseconds)
+	Latency ns.VarInt
+	// PlayerInfoUpdateDisplayName
+	DisplayName ns.PrefixedOptional[ns.TextComponent]
+	// PlayerInfoUpdateListOrder
+	ListOrder ns.VarInt
+	// PlayerInfoUpdateHat
+	ShowHat ns.Boolean
 }
 
 // S2CPlayerInfoUpdate represents "Player Info Update".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Player_Info_Update
 type S2CPlayerInfoUpdate struct {
-	Data ns.ByteArray
+	Actions PlayerInfoActions
+	Entries []PlayerInfoEntry
 }
 
 func (p *S2CPlayerInfoUpdate) Read(buf *ns.PacketBuffer) error {
+	actions, err := buf.ReadUint8()
+	if err != nil {
+		return err
+	}
+	p.Actions = PlayerInfoActions(actions)
+	count, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return fmt.Errorf("negative player info entry count %d", count)
+	}
+	p.Entries = make([]PlayerInfoEntry, count)
+	for i := range p.Entries {
+		if err := p.Entries[i].read(buf, p.Actions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *S2CPlayerInfoUpdate) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteUint8(ns.Uint8(p.Actions)); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(ns.VarInt(len(p.Entries))); err != nil {
+		return err
+	}
+	for i := range p.Entries {
+		if err := p.Entries[i].write(buf, p.Actions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *PlayerInfoEntry) read(buf *ns.PacketBuffer, actions PlayerInfoActions) error {
 	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if e.Uuid, err = buf.ReadUUID(); err != nil {
+		return err
+	}
+	if actions.Has(PlayerInfoAddPlayer) {
+		if e.Name, err = buf.ReadString(16); err != nil {
+			return err
+		}
+		if err = e.Properties.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.ProfileProperty, error) {
+			var prop ns.ProfileProperty
+			err := prop.Decode(b)
+			return prop, err
+		}); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoInitializeChat) {
+		if err = e.ChatSession.DecodeWith(buf, func(b *ns.PacketBuffer) (RemoteChatSession, error) {
+			var s RemoteChatSession
+			err := s.Read(b)
+			return s, err
+		}); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateGameMode) {
+		if e.GameMode, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateListed) {
+		if e.Listed, err = buf.ReadBool(); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateLatency) {
+		if e.Latency, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateDisplayName) {
+		if err = e.DisplayName.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.TextComponent, error) {
+			return b.ReadTextComponent()
+		}); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateListOrder) {
+		if e.ListOrder, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateHat) {
+		if e.ShowHat, err = buf.ReadBool(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (p *S2CPlayerInfoUpdate) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+func (e *PlayerInfoEntry) write(buf *ns.PacketBuffer, actions PlayerInfoActions) error {
+	if err := buf.WriteUUID(e.Uuid); err != nil {
+		return err
+	}
+	if actions.Has(PlayerInfoAddPlayer) {
+		if err := buf.WriteString(e.Name); err != nil {
+			return err
+		}
+		if err := e.Properties.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.ProfileProperty) error {
+			return v.Encode(b)
+		}); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoInitializeChat) {
+		if err := e.ChatSession.EncodeWith(buf, func(b *ns.PacketBuffer, v RemoteChatSession) error {
+			return v.Write(b)
+		}); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateGameMode) {
+		if err := buf.WriteVarInt(e.GameMode); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateListed) {
+		if err := buf.WriteBool(e.Listed); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateLatency) {
+		if err := buf.WriteVarInt(e.Latency); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateDisplayName) {
+		if err := e.DisplayName.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.TextComponent) error {
+			return b.WriteTextComponent(v)
+		}); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateListOrder) {
+		if err := buf.WriteVarInt(e.ListOrder); err != nil {
+			return err
+		}
+	}
+	if actions.Has(PlayerInfoUpdateHat) {
+		return buf.WriteBool(e.ShowHat)
+	}
+	return nil
 }
 
 // S2CPlayerLookAt represents "Look At".
@@ -2736,34 +4312,125 @@ func (p *S2CPlayerRotation) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Recipe_Book_Add
 type S2CRecipeBookAdd struct {
-	Data ns.ByteArray
+	Entries ns.PrefixedArray[RecipeBookEntry]
+	// Replace clears the recipe book before adding the entries.
+	Replace ns.Boolean
+}
+
+// RecipeBookEntry flags
+const (
+	RecipeBookShowNotification ns.Int8 = 0x01
+	RecipeBookHighlight        ns.Int8 = 0x02
+)
+
+// RecipeBookEntry is a recipe unlocked in the recipe book.
+type RecipeBookEntry struct {
+	// Id is the recipe display ID, used by C2SPlaceRecipe and C2SRecipeBookSeenRecipe.
+	Id      ns.VarInt
+	Display RecipeDisplay
+	// Group is the recipe group ID, or -1 if the recipe is not grouped.
+	Group ns.VarInt
+	// Category is the minecraft:recipe_book_category registry ID.
+	Category ns.VarInt
+	// CraftingRequirements lists the ingredients used to check whether the recipe is craftable.
+	CraftingRequirements ns.PrefixedOptional[ns.PrefixedArray[ns.IDSet]]
+	Flags                ns.Int8
 }
 
 func (p *S2CRecipeBookAdd) Read(buf *ns.PacketBuffer) error {
 	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
+	if err = p.Entries.DecodeWith(buf, func(b *ns.PacketBuffer) (RecipeBookEntry, error) {
+		var e RecipeBookEntry
+		err := e.Read(b)
+		return e, err
+	}); err != nil {
+		return err
+	}
+	p.Replace, err = buf.ReadBool()
 	return err
 }
 
 func (p *S2CRecipeBookAdd) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+	if err := p.Entries.EncodeWith(buf, func(b *ns.PacketBuffer, v RecipeBookEntry) error {
+		return v.Write(b)
+	}); err != nil {
+		return err
+	}
+	return buf.WriteBool(p.Replace)
+}
+
+func (e *RecipeBookEntry) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if e.Id, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if e.Display, err = ReadRecipeDisplay(buf); err != nil {
+		return err
+	}
+	group, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	e.Group = group - 1 // 0 means no group
+	if e.Category, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if err = e.CraftingRequirements.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.PrefixedArray[ns.IDSet], error) {
+		var ingredients ns.PrefixedArray[ns.IDSet]
+		err := ingredients.DecodeWith(b, func(b *ns.PacketBuffer) (ns.IDSet, error) {
+			var s ns.IDSet
+			err := s.Decode(b)
+			return s, err
+		})
+		return ingredients, err
+	}); err != nil {
+		return err
+	}
+	e.Flags, err = buf.ReadInt8()
+	return err
+}
+
+func (e *RecipeBookEntry) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(e.Id); err != nil {
+		return err
+	}
+	if err := WriteRecipeDisplay(buf, e.Display); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(max(e.Group, -1) + 1); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(e.Category); err != nil {
+		return err
+	}
+	if err := e.CraftingRequirements.EncodeWith(buf, func(b *ns.PacketBuffer, ingredients ns.PrefixedArray[ns.IDSet]) error {
+		return ingredients.EncodeWith(b, func(b *ns.PacketBuffer, s ns.IDSet) error {
+			return s.Encode(b)
+		})
+	}); err != nil {
+		return err
+	}
+	return buf.WriteInt8(e.Flags)
 }
 
 // S2CRecipeBookRemove represents "Recipe Book Remove".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Recipe_Book_Remove
 type S2CRecipeBookRemove struct {
-	Recipes ns.ByteArray
+	// Recipes are recipe display IDs.
+	Recipes ns.PrefixedArray[ns.VarInt]
 }
 
 func (p *S2CRecipeBookRemove) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.Recipes, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Recipes.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.VarInt, error) {
+		return b.ReadVarInt()
+	})
 }
 
 func (p *S2CRecipeBookRemove) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Recipes)
+	return p.Recipes.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.VarInt) error {
+		return b.WriteVarInt(v)
+	})
 }
 
 // S2CRecipeBookSettings represents "Recipe Book Settings".
@@ -2836,17 +4503,19 @@ func (p *S2CRecipeBookSettings) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Remove_Entities
 type S2CRemoveEntities struct {
-	EntityIds ns.ByteArray
+	EntityIds ns.PrefixedArray[ns.VarInt]
 }
 
 func (p *S2CRemoveEntities) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.EntityIds, err = buf.ReadByteArray(1048576)
-	return err
+	return p.EntityIds.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.VarInt, error) {
+		return b.ReadVarInt()
+	})
 }
 
 func (p *S2CRemoveEntities) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.EntityIds)
+	return p.EntityIds.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.VarInt) error {
+		return b.WriteVarInt(v)
+	})
 }
 
 // S2CRemoveMobEffect represents "Remove Entity Effect".
@@ -3395,10 +5064,20 @@ func (p *S2CSetDefaultSpawnPosition) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Display_Objective
 type S2CSetDisplayObjective struct {
-	Position  ns.VarInt
+	Position ns.VarInt
+	// ScoreName is the objective to show, or empty to clear the slot.
 	ScoreName ns.String
 }
 
+// display slots of S2CSetDisplayObjective
+const (
+	DisplaySlotList      ns.VarInt = 0
+	DisplaySlotSidebar   ns.VarInt = 1
+	DisplaySlotBelowName ns.VarInt = 2
+	// DisplaySlotTeamSidebar is the sidebar shown to members of teams with color 0; add the team color for other teams.
+	DisplaySlotTeamSidebar ns.VarInt = 3
+)
+
 func (p *S2CSetDisplayObjective) Read(buf *ns.PacketBuffer) error {
 	var err error
 	if p.Position, err = buf.ReadVarInt(); err != nil {
@@ -3492,7 +5171,37 @@ func (p *S2CSetEntityMotion) Write(buf *ns.PacketBuffer) error {
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Set_Equipment
 type S2CSetEquipment struct {
 	EntityId ns.VarInt
-	Data     ns.ByteArray
+	// Equipment holds the changed slots; an empty stack clears the slot.
+	Equipment map[EquipmentSlot]*items.ItemStack
+}
+
+// EquipmentSlot is an entity equipment slot.
+type EquipmentSlot int8
+
+const (
+	EquipmentMainHand EquipmentSlot = iota
+	EquipmentOffHand
+	EquipmentFeet
+	EquipmentLegs
+	EquipmentChest
+	EquipmentHead
+	EquipmentBody
+	EquipmentSaddle
+)
+
+var equipmentSlotNames = [...]string{"mainhand", "offhand", "feet", "legs", "chest", "head", "body", "saddle"}
+
+// String returns the slot name used by item components, e.g. "mainhand".
+func (s EquipmentSlot) String() string {
+	if s < 0 || int(s) >= len(equipmentSlotNames) {
+		return fmt.Sprintf("EquipmentSlot(%d)", int8(s))
+	}
+	return equipmentSlotNames[s]
+}
+
+// IsArmor reports whether the slot is one of the four armor slots.
+func (s EquipmentSlot) IsArmor() bool {
+	return s >= EquipmentFeet && s <= EquipmentHead
 }
 
 func (p *S2CSetEquipment) Read(buf *ns.PacketBuffer) error {
@@ -3500,15 +5209,52 @@ func (p *S2CSetEquipment) Read(buf *ns.PacketBuffer) error {
 	if p.EntityId, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	p.Equipment = make(map[EquipmentSlot]*items.ItemStack)
+	for {
+		// the high bit marks that another entry follows
+		b, err := buf.ReadUint8()
+		if err != nil {
+			return err
+		}
+		slot := EquipmentSlot(b & 0x7F)
+		if p.Equipment[slot], err = items.ReadSlot(buf); err != nil {
+			return fmt.Errorf("equipment slot %s: %w", slot, err)
+		}
+		if b&0x80 == 0 {
+			return nil
+		}
+	}
 }
 
 func (p *S2CSetEquipment) Write(buf *ns.PacketBuffer) error {
+	if len(p.Equipment) == 0 {
+		return fmt.Errorf("set equipment: no slots")
+	}
 	if err := buf.WriteVarInt(p.EntityId); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
+	slots := make([]EquipmentSlot, 0, len(p.Equipment))
+	for slot := range p.Equipment {
+		slots = append(slots, slot)
+	}
+	slices.Sort(slots)
+	for i, slot := range slots {
+		b := uint8(slot)
+		if i < len(slots)-1 {
+			b |= 0x80
+		}
+		if err := buf.WriteUint8(ns.Uint8(b)); err != nil {
+			return err
+		}
+		stack := p.Equipment[slot]
+		if stack == nil {
+			stack = items.EmptyStack()
+		}
+		if err := stack.WriteSlot(buf); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // S2CSetExperience represents "Set Experience".
@@ -3596,7 +5342,82 @@ func (p *S2CSetHeldSlot) Write(buf *ns.PacketBuffer) error {
 type S2CSetObjective struct {
 	ObjectiveName ns.String
 	Mode          ns.Int8
-	Data          ns.ByteArray
+	// ObjectiveModeCreate and ObjectiveModeUpdate only
+	DisplayName  ns.TextComponent
+	RenderType   ns.VarInt
+	NumberFormat ns.PrefixedOptional[NumberFormat]
+}
+
+// S2CSetObjective modes
+const (
+	ObjectiveModeCreate ns.Int8 = iota
+	ObjectiveModeRemove
+	ObjectiveModeUpdate
+)
+
+// objective render types
+const (
+	ObjectiveRenderInteger ns.VarInt = iota
+	ObjectiveRenderHearts
+)
+
+// NumberFormatType is how scores are shown.
+type NumberFormatType ns.VarInt
+
+const (
+	NumberFormatBlank NumberFormatType = iota
+	NumberFormatStyled
+	NumberFormatFixed
+)
+
+// NumberFormat overrides how scores are shown.
+type NumberFormat struct {
+	Type NumberFormatType
+	// Style is the style of the score for NumberFormatStyled; only its style fields are used.
+	Style ns.TextComponent
+	// Content replaces the score for NumberFormatFixed.
+	Content ns.TextComponent
+}
+
+func (f *NumberFormat) Read(buf *ns.PacketBuffer) error {
+	formatType, err := buf.ReadVarInt()
+	if err != nil {
+		return err
+	}
+	f.Type = NumberFormatType(formatType)
+	switch f.Type {
+	case NumberFormatBlank:
+	case NumberFormatStyled:
+		f.Style, err = buf.ReadTextComponent()
+	case NumberFormatFixed:
+		f.Content, err = buf.ReadTextComponent()
+	default:
+		err = fmt.Errorf("unknown number format type %d", formatType)
+	}
+	return err
+}
+
+func (f *NumberFormat) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(ns.VarInt(f.Type)); err != nil {
+		return err
+	}
+	switch f.Type {
+	case NumberFormatStyled:
+		return buf.WriteTextComponent(f.Style)
+	case NumberFormatFixed:
+		return buf.WriteTextComponent(f.Content)
+	}
+	return nil
+}
+
+func readNumberFormat(b *ns.PacketBuffer) (NumberFormat, error) {
+	var f NumberFormat
+	err := f.Read(b)
+	return f, err
+}
+
+func writeNumberFormat(b *ns.PacketBuffer, f NumberFormat) error {
+	return f.Write(b)
 }
 
 func (p *S2CSetObjective) Read(buf *ns.PacketBuffer) error {
@@ -3607,10 +5428,16 @@ func (p *S2CSetObjective) Read(buf *ns.PacketBuffer) error {
 	if p.Mode, err = buf.ReadInt8(); err != nil {
 		return err
 	}
-	if p.Mode == 0 || p.Mode == 2 {
-		p.Data, err = buf.ReadByteArray(1048576)
+	if p.Mode != ObjectiveModeCreate && p.Mode != ObjectiveModeUpdate {
+		return nil
 	}
-	return err
+	if p.DisplayName, err = buf.ReadTextComponent(); err != nil {
+		return err
+	}
+	if p.RenderType, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	return p.NumberFormat.DecodeWith(buf, readNumberFormat)
 }
 
 func (p *S2CSetObjective) Write(buf *ns.PacketBuffer) error {
@@ -3620,18 +5447,25 @@ func (p *S2CSetObjective) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteInt8(p.Mode); err != nil {
 		return err
 	}
-	if p.Mode == 0 || p.Mode == 2 {
-		return buf.WriteByteArray(p.Data)
+	if p.Mode != ObjectiveModeCreate && p.Mode != ObjectiveModeUpdate {
+		return nil
 	}
-	return nil
+	if err := buf.WriteTextComponent(p.DisplayName); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(p.RenderType); err != nil {
+		return err
+	}
+	return p.NumberFormat.EncodeWith(buf, writeNumberFormat)
 }
 
 // S2CSetPassengers represents "Set Passengers".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Set_Passengers
 type S2CSetPassengers struct {
-	EntityId   ns.VarInt
-	Passengers ns.ByteArray
+	EntityId ns.VarInt
+	// Passengers are the entity IDs of all passengers, replacing the previous ones.
+	Passengers ns.PrefixedArray[ns.VarInt]
 }
 
 func (p *S2CSetPassengers) Read(buf *ns.PacketBuffer) error {
@@ -3639,15 +5473,18 @@ func (p *S2CSetPassengers) Read(buf *ns.PacketBuffer) error {
 	if p.EntityId, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Passengers, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Passengers.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.VarInt, error) {
+		return b.ReadVarInt()
+	})
 }
 
 func (p *S2CSetPassengers) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteVarInt(p.EntityId); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Passengers)
+	return p.Passengers.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.VarInt) error {
+		return b.WriteVarInt(v)
+	})
 }
 
 // S2CSetPlayerInventory represents "Set Player Inventory Slot".
@@ -3680,7 +5517,59 @@ func (p *S2CSetPlayerInventory) Write(buf *ns.PacketBuffer) error {
 type S2CSetPlayerTeam struct {
 	TeamName ns.String
 	Method   ns.Int8
-	Data     ns.ByteArray
+	// TeamMethodCreate and TeamMethodUpdate only
+	DisplayName       ns.TextComponent
+	Options           ns.Int8
+	NameTagVisibility ns.VarInt
+	CollisionRule     ns.VarInt
+	// Color is the ChatFormatting ID of the team color (0-15), or TeamColorReset.
+	Color  ns.VarInt
+	Prefix ns.TextComponent
+	Suffix ns.TextComponent
+	// TeamMethodCreate, TeamMethodAddPlayers and TeamMethodRemovePlayers only
+	Players ns.PrefixedArray[ns.String]
+}
+
+// S2CSetPlayerTeam methods
+const (
+	TeamMethodCreate ns.Int8 = iota
+	TeamMethodRemove
+	TeamMethodUpdate
+	TeamMethodAddPlayers
+	TeamMethodRemovePlayers
+)
+
+// team option flags
+const (
+	TeamFriendlyFire          ns.Int8 = 0x01
+	TeamSeeFriendlyInvisibles ns.Int8 = 0x02
+)
+
+// team name tag visibility
+const (
+	NameTagAlways ns.VarInt = iota
+	NameTagNever
+	NameTagHideForOtherTeams
+	NameTagHideForOwnTeam
+)
+
+// team collision rules
+const (
+	CollisionAlways ns.VarInt = iota
+	CollisionNever
+	CollisionPushOtherTeams
+	CollisionPushOwnTeam
+)
+
+// TeamColorReset is the team color of teams without a color.
+const TeamColorReset ns.VarInt = 21
+
+func (p *S2CSetPlayerTeam) hasParameters() bool {
+	return p.Method == TeamMethodCreate || p.Method == TeamMethodUpdate
+}
+
+func (p *S2CSetPlayerTeam) hasPlayers() bool {
+	return p.Method == TeamMethodCreate || p.Method == TeamMethodAddPlayers || p.Method == TeamMethodRemovePlayers
 }
 
 func (p *S2CSetPlayerTeam) Read(buf *ns.PacketBuffer) error {
@@ -3691,8 +5580,35 @@ func (p *S2CSetPlayerTeam) Read(buf *ns.PacketBuffer) error {
 	if p.Method, err = buf.ReadInt8(); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if p.hasParameters() {
+		if p.DisplayName, err = buf.ReadTextComponent(); err != nil {
+			return err
+		}
+		if p.Options, err = buf.ReadInt8(); err != nil {
+			return err
+		}
+		if p.NameTagVisibility, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		if p.CollisionRule, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		if p.Color, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		if p.Prefix, err = buf.ReadTextComponent(); err != nil {
+			return err
+		}
+		if p.Suffix, err = buf.ReadTextComponent(); err != nil {
+			return err
+		}
+	}
+	if p.hasPlayers() {
+		return p.Players.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.String, error) {
+			return b.ReadString(32767)
+		})
+	}
+	return nil
 }
 
 func (p *S2CSetPlayerTeam) Write(buf *ns.PacketBuffer) error {
@@ -3702,7 +5618,35 @@ func (p *S2CSetPlayerTeam) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteInt8(p.Method); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
+	if p.hasParameters() {
+		if err := buf.WriteTextComponent(p.DisplayName); err != nil {
+			return err
+		}
+		if err := buf.WriteInt8(p.Options); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(p.NameTagVisibility); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(p.CollisionRule); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(p.Color); err != nil {
+			return err
+		}
+		if err := buf.WriteTextComponent(p.Prefix); err != nil {
+			return err
+		}
+		if err := buf.WriteTextComponent(p.Suffix); err != nil {
+			return err
+		}
+	}
+	if p.hasPlayers() {
+		return p.Players.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.String) error {
+			return b.WriteString(v)
+		})
+	}
+	return nil
 }
 
 // S2CSetScore represents "Update Score".
@@ -3712,7 +5656,9 @@ type S2CSetScore struct {
 	EntityName    ns.String
 	ObjectiveName ns.String
 	Value         ns.VarInt
-	Data          ns.ByteArray
+	// DisplayName replaces the entity name in the sidebar and player list.
+	DisplayName  ns.PrefixedOptional[ns.TextComponent]
+	NumberFormat ns.PrefixedOptional[NumberFormat]
 }
 
 func (p *S2CSetScore) Read(buf *ns.PacketBuffer) error {
@@ -3726,8 +5672,12 @@ func (p *S2CSetScore) Read(buf *ns.PacketBuffer) error {
 	if p.Value, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if err = p.DisplayName.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.TextComponent, error) {
+		return b.ReadTextComponent()
+	}); err != nil {
+		return err
+	}
+	return p.NumberFormat.DecodeWith(buf, readNumberFormat)
 }
 
 func (p *S2CSetScore) Write(buf *ns.PacketBuffer) error {
@@ -3740,7 +5690,12 @@ func (p *S2CSetScore) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteVarInt(p.Value); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
+	if err := p.DisplayName.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.TextComponent) error {
+		return b.WriteTextComponent(v)
+	}); err != nil {
+		return err
+	}
+	return p.NumberFormat.EncodeWith(buf, writeNumberFormat)
 }
 
 // S2CSetSimulationDistance represents "Set Simulation Distance".
@@ -3883,21 +5838,94 @@ func (p *S2CSetTitlesAnimation) Read(buf *ns.PacketBuffer) error {
 	return err
 }
 
-func (p *S2CSetTitlesAnimation) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteInt32(p.FadeIn); err != nil {
-		return err
+func (p *S2CSetTitlesAnimation) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteInt32(p.FadeIn); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(p.Stay); err != nil {
+		return err
+	}
+	return buf.WriteInt32(p.FadeOut)
+}
+
+// SoundEvent is an inline sound event definition.
+type SoundEvent struct {
+	SoundId ns.Identifier
+	// FixedRange is the audible range, or absent for a range based on the volume.
+	FixedRange ns.PrefixedOptional[ns.Float32]
+}
+
+func (s *SoundEvent) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if s.SoundId, err = buf.ReadIdentifier(); err != nil {
+		return err
+	}
+	return s.FixedRange.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.Float32, error) { return b.ReadFloat32() })
+}
+
+func (s SoundEvent) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteIdentifier(s.SoundId); err != nil {
+		return err
+	}
+	return s.FixedRange.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.Float32) error { return b.WriteFloat32(v) })
+}
+
+// SoundEventHolder is a sound event of the minecraft:sound_event registry,
+// or an inline definition for unregistered sounds (e.g. from resource packs).
+type SoundEventHolder struct {
+	ns.IDOrX[SoundEvent]
+}
+
+// NewSoundEventHolder returns a reference to a registered sound event,
+// or an inline definition if the name is not registered.
+func NewSoundEventHolder(name string) SoundEventHolder {
+	if id := registries.SoundEvent.Get(name); id >= 0 {
+		return SoundEventHolder{ns.NewIDRef[SoundEvent](ns.VarInt(id))}
 	}
-	if err := buf.WriteInt32(p.Stay); err != nil {
-		return err
+	return SoundEventHolder{ns.NewInlineValue(SoundEvent{SoundId: ns.Identifier(name)})}
+}
+
+// Name returns the sound event identifier, e.g. "minecraft:entity.creeper.primed",
+// or empty if the registry ID is unknown.
+func (h SoundEventHolder) Name() string {
+	if h.IsInline {
+		return string(h.Value.SoundId)
 	}
-	return buf.WriteInt32(p.FadeOut)
+	return registries.SoundEvent.ByID(int32(h.ID))
 }
 
+func (h *SoundEventHolder) Read(buf *ns.PacketBuffer) error {
+	return h.DecodeWith(buf, func(b *ns.PacketBuffer) (SoundEvent, error) {
+		var s SoundEvent
+		err := s.Read(b)
+		return s, err
+	})
+}
+
+func (h SoundEventHolder) Write(buf *ns.PacketBuffer) error {
+	return h.EncodeWith(buf, func(b *ns.PacketBuffer, s SoundEvent) error { return s.Write(b) })
+}
+
+// sound sources (S2CSound.SoundCategory), each with its own volume slider in the client
+const (
+	SoundSourceMaster ns.VarInt = iota
+	SoundSourceMusic
+	SoundSourceRecords
+	SoundSourceWeather
+	SoundSourceBlocks
+	SoundSourceHostile
+	SoundSourceNeutral
+	SoundSourcePlayers
+	SoundSourceAmbient
+	SoundSourceVoice
+	SoundSourceUI
+)
+
 // S2CSoundEntity represents "Entity Sound Effect".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Entity_Sound_Effect
 type S2CSoundEntity struct {
-	SoundEvent    ns.ByteArray
+	SoundEvent    SoundEventHolder
 	SoundCategory ns.VarInt
 	EntityId      ns.VarInt
 	Volume        ns.Float32
@@ -3907,7 +5935,7 @@ type S2CSoundEntity struct {
 
 func (p *S2CSoundEntity) Read(buf *ns.PacketBuffer) error {
 	var err error
-	if p.SoundEvent, err = buf.ReadByteArray(1048576); err != nil {
+	if err = p.SoundEvent.Read(buf); err != nil {
 		return err
 	}
 	if p.SoundCategory, err = buf.ReadVarInt(); err != nil {
@@ -3927,7 +5955,7 @@ func (p *S2CSoundEntity) Read(buf *ns.PacketBuffer) error {
 }
 
 func (p *S2CSoundEntity) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteByteArray(p.SoundEvent); err != nil {
+	if err := p.SoundEvent.Write(buf); err != nil {
 		return err
 	}
 	if err := buf.WriteVarInt(p.SoundCategory); err != nil {
@@ -3949,7 +5977,7 @@ func (p *S2CSoundEntity) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Sound_Effect
 type S2CSound struct {
-	SoundEvent      ns.ByteArray
+	SoundEvent      SoundEventHolder
 	SoundCategory   ns.VarInt
 	EffectPositionX ns.Int32
 	EffectPositionY ns.Int32
@@ -3961,7 +5989,7 @@ type S2CSound struct {
 
 func (p *S2CSound) Read(buf *ns.PacketBuffer) error {
 	var err error
-	if p.SoundEvent, err = buf.ReadByteArray(1048576); err != nil {
+	if err = p.SoundEvent.Read(buf); err != nil {
 		return err
 	}
 	if p.SoundCategory, err = buf.ReadVarInt(); err != nil {
@@ -3987,7 +6015,7 @@ func (p *S2CSound) Read(buf *ns.PacketBuffer) error {
 }
 
 func (p *S2CSound) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteByteArray(p.SoundEvent); err != nil {
+	if err := p.SoundEvent.Write(buf); err != nil {
 		return err
 	}
 	if err := buf.WriteVarInt(p.SoundCategory); err != nil {
@@ -4207,10 +6235,25 @@ type S2CTeleportEntity struct {
 	VelocityZ ns.Float64
 	Yaw       ns.Float32
 	Pitch     ns.Float32
-	Flags     ns.Int8
-	OnGround  ns.Boolean
+	// Flags is a bit set of Teleport* values marking the relative fields.
+	Flags    ns.Int32
+	OnGround ns.Boolean
 }
 
+// relative teleport flags of S2CTeleportEntity and S2CPlayerPosition
+const (
+	TeleportRelativeX ns.Int32 = 1 << iota
+	TeleportRelativeY
+	TeleportRelativeZ
+	TeleportRelativeYaw
+	TeleportRelativePitch
+	TeleportRelativeVelocityX
+	TeleportRelativeVelocityY
+	TeleportRelativeVelocityZ
+	// TeleportRotateVelocity rotates the current velocity by the change in rotation.
+	TeleportRotateVelocity
+)
+
 func (p *S2CTeleportEntity) Read(buf *ns.PacketBuffer) error {
 	var err error
 	if p.EntityId, err = buf.ReadVarInt(); err != nil {
@@ -4219,183 +6262,512 @@ func (p *S2CTeleportEntity) Read(buf *ns.PacketBuffer) error {
 	if p.X, err = buf.ReadFloat64(); err != nil {
 		return err
 	}
-	if p.Y, err = buf.ReadFloat64(); err != nil {
+	if p.Y, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.Z, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.VelocityX, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.VelocityY, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.VelocityZ, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	if p.Yaw, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	if p.Pitch, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	if p.Flags, err = buf.ReadInt32(); err != nil {
+		return err
+	}
+	p.OnGround, err = buf.ReadBool()
+	return err
+}
+
+func (p *S2CTeleportEntity) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(p.EntityId); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.X); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.Y); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.Z); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.VelocityX); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.VelocityY); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(p.VelocityZ); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(p.Yaw); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat32(p.Pitch); err != nil {
+		return err
+	}
+	if err := buf.WriteInt32(p.Flags); err != nil {
+		return err
+	}
+	return buf.WriteBool(p.OnGround)
+}
+
+// S2CTestInstanceBlockStatus represents "Test Instance Block Status".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Test_Instance_Block_Status
+type S2CTestInstanceBlockStatus struct {
+	Status ns.TextComponent
+	Size   ns.PrefixedOptional[ns.ByteArray]
+}
+
+func (p *S2CTestInstanceBlockStatus) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.Status, err = buf.ReadTextComponent(); err != nil {
+		return err
+	}
+	return p.Size.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.ByteArray, error) {
+		return b.ReadByteArray(24)
+	})
+}
+
+func (p *S2CTestInstanceBlockStatus) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteTextComponent(p.Status); err != nil {
+		return err
+	}
+	return p.Size.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.ByteArray) error {
+		return b.WriteByteArray(v)
+	})
+}
+
+// S2CTickingState represents "Set Ticking State".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Set_Ticking_State
+type S2CTickingState struct {
+	TickRate ns.Float32
+	IsFrozen ns.Boolean
+}
+
+func (p *S2CTickingState) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.TickRate, err = buf.ReadFloat32(); err != nil {
+		return err
+	}
+	p.IsFrozen, err = buf.ReadBool()
+	return err
+}
+
+func (p *S2CTickingState) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteFloat32(p.TickRate); err != nil {
+		return err
+	}
+	return buf.WriteBool(p.IsFrozen)
+}
+
+// S2CTickingStep represents "Step Tick".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Step_Tick
+type S2CTickingStep struct {
+	TickSteps ns.VarInt
+}
+
+func (p *S2CTickingStep) Read(buf *ns.PacketBuffer) error {
+	var err error
+	p.TickSteps, err = buf.ReadVarInt()
+	return err
+}
+
+func (p *S2CTickingStep) Write(buf *ns.PacketBuffer) error {
+	return buf.WriteVarInt(p.TickSteps)
+}
+
+// S2CTransferPlay represents "Transfer (play)".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Transfer_(Play)
+type S2CTransferPlay struct {
+	Host ns.String
+	Port ns.VarInt
+}
+
+func (p *S2CTransferPlay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.Host, err = buf.ReadString(32767); err != nil {
+		return err
+	}
+	p.Port, err = buf.ReadVarInt()
+	return err
+}
+
+func (p *S2CTransferPlay) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteString(p.Host); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(p.Port)
+}
+
+// S2CUpdateAdvancements represents "Update Advancements".
+//
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Advancements
+type S2CUpdateAdvancements struct {
+	// Reset clears all previously sent advancements and progress.
+	Reset            ns.Boolean
+	Added            ns.PrefixedArray[AdvancementHolder]
+	Removed          ns.PrefixedArray[ns.Identifier]
+	Progress         ns.PrefixedArray[AdvancementProgress]
+	ShowAdvancements ns.Boolean
+}
+
+// AdvancementHolder is an advancement with its identifier.
+type AdvancementHolder struct {
+	Id          ns.Identifier
+	Advancement Advancement
+}
+
+// Advancement is the network form of an advancement (criteria are not sent).
+type Advancement struct {
+	Parent  ns.PrefixedOptional[ns.Identifier]
+	Display ns.PrefixedOptional[AdvancementDisplay]
+	// Requirements lists groups of criterion names; the advancement is done
+	// when at least one criterion of every group is obtained.
+	Requirements        ns.PrefixedArray[ns.PrefixedArray[ns.String]]
+	SendsTelemetryEvent ns.Boolean
+}
+
+// AdvancementFrame is the frame type of an advancement icon.
+type AdvancementFrame ns.VarInt
+
+const (
+	AdvancementFrameTask AdvancementFrame = iota
+	AdvancementFrameChallenge
+	AdvancementFrameGoal
+)
+
+// AdvancementDisplay flags
+const (
+	AdvancementHasBackground ns.Int32 = 0x01
+	AdvancementShowToast     ns.Int32 = 0x02
+	AdvancementHidden        ns.Int32 = 0x04
+)
+
+// AdvancementDisplay is how an advancement is shown in the advancements screen.
+type AdvancementDisplay struct {
+	Title       ns.TextComponent
+	Description ns.TextComponent
+	Icon        *items.ItemStack
+	Frame       AdvancementFrame
+	Flags       ns.Int32
+	// Background is the texture of the tab, only sent if Flags has AdvancementHasBackground.
+	Background ns.Identifier
+	X          ns.Float32
+	Y          ns.Float32
+}
+
+// AdvancementProgress is the progress of a single advancement.
+type AdvancementProgress struct {
+	Id       ns.Identifier
+	Criteria ns.PrefixedArray[CriterionProgress]
+}
+
+// CriterionProgress is the progress of a single criterion.
+type CriterionProgress struct {
+	Criterion ns.String
+	// ObtainedAt is the time the criterion was obtained, in milliseconds since the Unix epoch.
+	ObtainedAt ns.PrefixedOptional[ns.Int64]
+}
+
+func (p *S2CUpdateAdvancements) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if p.Reset, err = buf.ReadBool(); err != nil {
+		return err
+	}
+	if err = p.Added.DecodeWith(buf, func(b *ns.PacketBuffer) (AdvancementHolder, error) {
+		var h AdvancementHolder
+		err := h.Read(b)
+		return h, err
+	}); err != nil {
+		return err
+	}
+	if err = p.Removed.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.Identifier, error) {
+		return b.ReadIdentifier()
+	}); err != nil {
+		return err
+	}
+	if err = p.Progress.DecodeWith(buf, func(b *ns.PacketBuffer) (AdvancementProgress, error) {
+		var ap AdvancementProgress
+		err := ap.Read(b)
+		return ap, err
+	}); err != nil {
+		return err
+	}
+	p.ShowAdvancements, err = buf.ReadBool()
+	return err
+}
+
+func (p *S2CUpdateAdvancements) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteBool(p.Reset); err != nil {
+		return err
+	}
+	if err := p.Added.EncodeWith(buf, func(b *ns.PacketBuffer, v AdvancementHolder) error {
+		return v.Write(b)
+	}); err != nil {
+		return err
+	}
+	if err := p.Removed.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.Identifier) error {
+		return b.WriteIdentifier(v)
+	}); err != nil {
+		return err
+	}
+	if err := p.Progress.EncodeWith(buf, func(b *ns.PacketBuffer, v AdvancementProgress) error {
+		return v.Write(b)
+	}); err != nil {
+		return err
+	}
+	return buf.WriteBool(p.ShowAdvancements)
+}
+
+func (h *AdvancementHolder) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if h.Id, err = buf.ReadIdentifier(); err != nil {
+		return err
+	}
+	return h.Advancement.Read(buf)
+}
+
+func (h *AdvancementHolder) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteIdentifier(h.Id); err != nil {
+		return err
+	}
+	return h.Advancement.Write(buf)
+}
+
+func (a *Advancement) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if err = a.Parent.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.Identifier, error) {
+		return b.ReadIdentifier()
+	}); err != nil {
+		return err
+	}
+	if err = a.Display.DecodeWith(buf, func(b *ns.PacketBuffer) (AdvancementDisplay, error) {
+		var d AdvancementDisplay
+		err := d.Read(b)
+		return d, err
+	}); err != nil {
+		return err
+	}
+	if err = a.Requirements.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.PrefixedArray[ns.String], error) {
+		var group ns.PrefixedArray[ns.String]
+		err := group.DecodeWith(b, func(b *ns.PacketBuffer) (ns.String, error) {
+			return b.ReadString(32767)
+		})
+		return group, err
+	}); err != nil {
+		return err
+	}
+	a.SendsTelemetryEvent, err = buf.ReadBool()
+	return err
+}
+
+func (a *Advancement) Write(buf *ns.PacketBuffer) error {
+	if err := a.Parent.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.Identifier) error {
+		return b.WriteIdentifier(v)
+	}); err != nil {
+		return err
+	}
+	if err := a.Display.EncodeWith(buf, func(b *ns.PacketBuffer, v AdvancementDisplay) error {
+		return v.Write(b)
+	}); err != nil {
 		return err
 	}
-	if p.Z, err = buf.ReadFloat64(); err != nil {
+	if err := a.Requirements.EncodeWith(buf, func(b *ns.PacketBuffer, group ns.PrefixedArray[ns.String]) error {
+		return group.EncodeWith(b, func(b *ns.PacketBuffer, v ns.String) error {
+			return b.WriteString(v)
+		})
+	}); err != nil {
 		return err
 	}
-	if p.VelocityX, err = buf.ReadFloat64(); err != nil {
+	return buf.WriteBool(a.SendsTelemetryEvent)
+}
+
+func (d *AdvancementDisplay) Read(buf *ns.PacketBuffer) error {
+	var err error
+	if d.Title, err = buf.ReadTextComponent(); err != nil {
 		return err
 	}
-	if p.VelocityY, err = buf.ReadFloat64(); err != nil {
+	if d.Description, err = buf.ReadTextComponent(); err != nil {
 		return err
 	}
-	if p.VelocityZ, err = buf.ReadFloat64(); err != nil {
+	if d.Icon, err = items.ReadSlot(buf); err != nil {
 		return err
 	}
-	if p.Yaw, err = buf.ReadFloat32(); err != nil {
+	frame, err := buf.ReadVarInt()
+	if err != nil {
 		return err
 	}
-	if p.Pitch, err = buf.ReadFloat32(); err != nil {
+	d.Frame = AdvancementFrame(frame)
+	if d.Flags, err = buf.ReadInt32(); err != nil {
 		return err
 	}
-	if p.Flags, err = buf.ReadInt8(); err != nil {
+	if d.Flags&AdvancementHasBackground != 0 {
+		if d.Background, err = buf.ReadIdentifier(); err != nil {
+			return err
+		}
+	}
+	if d.X, err = buf.ReadFloat32(); err != nil {
 		return err
 	}
-	p.OnGround, err = buf.ReadBool()
+	d.Y, err = buf.ReadFloat32()
 	return err
 }
 
-func (p *S2CTeleportEntity) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteVarInt(p.EntityId); err != nil {
-		return err
-	}
-	if err := buf.WriteFloat64(p.X); err != nil {
-		return err
-	}
-	if err := buf.WriteFloat64(p.Y); err != nil {
+func (d *AdvancementDisplay) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteTextComponent(d.Title); err != nil {
 		return err
 	}
-	if err := buf.WriteFloat64(p.Z); err != nil {
+	if err := buf.WriteTextComponent(d.Description); err != nil {
 		return err
 	}
-	if err := buf.WriteFloat64(p.VelocityX); err != nil {
-		return err
+	icon := d.Icon
+	if icon == nil {
+		icon = items.EmptyStack()
 	}
-	if err := buf.WriteFloat64(p.VelocityY); err != nil {
+	if err := icon.WriteSlot(buf); err != nil {
 		return err
 	}
-	if err := buf.WriteFloat64(p.VelocityZ); err != nil {
+	if err := buf.WriteVarInt(ns.VarInt(d.Frame)); err != nil {
 		return err
 	}
-	if err := buf.WriteFloat32(p.Yaw); err != nil {
+	if err := buf.WriteInt32(d.Flags); err != nil {
 		return err
 	}
-	if err := buf.WriteFloat32(p.Pitch); err != nil {
-		return err
+	if d.Flags&AdvancementHasBackground != 0 {
+		if err := buf.WriteIdentifier(d.Background); err != nil {
+			return err
+		}
 	}
-	if err := buf.WriteInt8(p.Flags); err != nil {
+	if err := buf.WriteFloat32(d.X); err != nil {
 		return err
 	}
-	return buf.WriteBool(p.OnGround)
-}
-
-// S2CTestInstanceBlockStatus represents "Test Instance Block Status".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Test_Instance_Block_Status
-type S2CTestInstanceBlockStatus struct {
-	Status ns.TextComponent
-	Size   ns.PrefixedOptional[ns.ByteArray]
+	return buf.WriteFloat32(d.Y)
 }
 
-func (p *S2CTestInstanceBlockStatus) Read(buf *ns.PacketBuffer) error {
+func (ap *AdvancementProgress) Read(buf *ns.PacketBuffer) error {
 	var err error
-	if p.Status, err = buf.ReadTextComponent(); err != nil {
+	if ap.Id, err = buf.ReadIdentifier(); err != nil {
 		return err
 	}
-	return p.Size.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.ByteArray, error) {
-		return b.ReadByteArray(24)
+	return ap.Criteria.DecodeWith(buf, func(b *ns.PacketBuffer) (CriterionProgress, error) {
+		var c CriterionProgress
+		var err error
+		if c.Criterion, err = b.ReadString(32767); err != nil {
+			return c, err
+		}
+		err = c.ObtainedAt.DecodeWith(b, func(b *ns.PacketBuffer) (ns.Int64, error) {
+			return b.ReadInt64()
+		})
+		return c, err
 	})
 }
 
-func (p *S2CTestInstanceBlockStatus) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteTextComponent(p.Status); err != nil {
+func (ap *AdvancementProgress) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteIdentifier(ap.Id); err != nil {
 		return err
 	}
-	return p.Size.EncodeWith(buf, func(b *ns.PacketBuffer, v ns.ByteArray) error {
-		return b.WriteByteArray(v)
+	return ap.Criteria.EncodeWith(buf, func(b *ns.PacketBuffer, c CriterionProgress) error {
+		if err := b.WriteString(c.Criterion); err != nil {
+			return err
+		}
+		return c.ObtainedAt.EncodeWith(b, func(b *ns.PacketBuffer, v ns.Int64) error {
+			return b.WriteInt64(v)
+		})
 	})
 }
 
-// S2CTickingState represents "Set Ticking State".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Set_Ticking_State
-type S2CTickingState struct {
-	TickRate ns.Float32
-	IsFrozen ns.Boolean
-}
-
-func (p *S2CTickingState) Read(buf *ns.PacketBuffer) error {
-	var err error
-	if p.TickRate, err = buf.ReadFloat32(); err != nil {
-		return err
-	}
-	p.IsFrozen, err = buf.ReadBool()
-	return err
-}
-
-func (p *S2CTickingState) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteFloat32(p.TickRate); err != nil {
-		return err
-	}
-	return buf.WriteBool(p.IsFrozen)
-}
-
-// S2CTickingStep represents "Step Tick".
+// S2CUpdateAttributes represents "Update Attributes".
 //
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Step_Tick
-type S2CTickingStep struct {
-	TickSteps ns.VarInt
+// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Attributes
+type S2CUpdateAttributes struct {
+	EntityId   ns.VarInt
+	Attributes ns.PrefixedArray[AttributeSnapshot]
 }
 
-func (p *S2CTickingStep) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.TickSteps, err = buf.ReadVarInt()
-	return err
+// AttributeSnapshot is the base value and modifiers of an entity attribute.
+type AttributeSnapshot struct {
+	// Attribute is the minecraft:attribute registry ID.
+	Attribute ns.VarInt
+	Base      ns.Float64
+	Modifiers ns.PrefixedArray[AttributeModifier]
 }
 
-func (p *S2CTickingStep) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteVarInt(p.TickSteps)
+// AttributeModifier is a modifier of an attribute snapshot.
+type AttributeModifier struct {
+	Id        ns.Identifier
+	Amount    ns.Float64
+	Operation ns.VarInt
 }
 
-// S2CTransferPlay represents "Transfer (play)".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Transfer_(Play)
-type S2CTransferPlay struct {
-	Host ns.String
-	Port ns.VarInt
-}
+// attribute modifier operations, applied in this order
+const (
+	AttributeAddValue ns.VarInt = iota
+	AttributeAddMultipliedBase
+	AttributeAddMultipliedTotal
+)
 
-func (p *S2CTransferPlay) Read(buf *ns.PacketBuffer) error {
+func (m *AttributeModifier) Read(buf *ns.PacketBuffer) error {
 	var err error
-	if p.Host, err = buf.ReadString(32767); err != nil {
+	if m.Id, err = buf.ReadIdentifier(); err != nil {
 		return err
 	}
-	p.Port, err = buf.ReadVarInt()
+	if m.Amount, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	m.Operation, err = buf.ReadVarInt()
 	return err
 }
 
-func (p *S2CTransferPlay) Write(buf *ns.PacketBuffer) error {
-	if err := buf.WriteString(p.Host); err != nil {
+func (m AttributeModifier) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteIdentifier(m.Id); err != nil {
 		return err
 	}
-	return buf.WriteVarInt(p.Port)
-}
-
-// S2CUpdateAdvancements represents "Update Advancements".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Advancements
-type S2CUpdateAdvancements struct {
-	Data ns.ByteArray
+	if err := buf.WriteFloat64(m.Amount); err != nil {
+		return err
+	}
+	return buf.WriteVarInt(m.Operation)
 }
 
-func (p *S2CUpdateAdvancements) Read(buf *ns.PacketBuffer) error {
+func (a *AttributeSnapshot) Read(buf *ns.PacketBuffer) error {
 	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
-}
-
-func (p *S2CUpdateAdvancements) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+	if a.Attribute, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	if a.Base, err = buf.ReadFloat64(); err != nil {
+		return err
+	}
+	return a.Modifiers.DecodeWith(buf, func(b *ns.PacketBuffer) (AttributeModifier, error) {
+		var m AttributeModifier
+		err := m.Read(b)
+		return m, err
+	})
 }
 
-// S2CUpdateAttributes represents "Update Attributes".
-//
-// https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Attributes
-type S2CUpdateAttributes struct {
-	EntityId ns.VarInt
-	Data     ns.ByteArray
+func (a AttributeSnapshot) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteVarInt(a.Attribute); err != nil {
+		return err
+	}
+	if err := buf.WriteFloat64(a.Base); err != nil {
+		return err
+	}
+	return a.Modifiers.EncodeWith(buf, func(b *ns.PacketBuffer, m AttributeModifier) error { return m.Write(b) })
 }
 
 func (p *S2CUpdateAttributes) Read(buf *ns.PacketBuffer) error {
@@ -4403,15 +6775,18 @@ func (p *S2CUpdateAttributes) Read(buf *ns.PacketBuffer) error {
 	if p.EntityId, err = buf.ReadVarInt(); err != nil {
 		return err
 	}
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Attributes.DecodeWith(buf, func(b *ns.PacketBuffer) (AttributeSnapshot, error) {
+		var a AttributeSnapshot
+		err := a.Read(b)
+		return a, err
+	})
 }
 
 func (p *S2CUpdateAttributes) Write(buf *ns.PacketBuffer) error {
 	if err := buf.WriteVarInt(p.EntityId); err != nil {
 		return err
 	}
-	return buf.WriteByteArray(p.Data)
+	return p.Attributes.EncodeWith(buf, func(b *ns.PacketBuffer, a AttributeSnapshot) error { return a.Write(b) })
 }
 
 // S2CUpdateMobEffect represents "Entity Effect".
@@ -4463,34 +6838,83 @@ func (p *S2CUpdateMobEffect) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Recipes
 type S2CUpdateRecipes struct {
-	Data ns.ByteArray
+	PropertySets       ns.PrefixedArray[RecipePropertySet]
+	StonecutterRecipes ns.PrefixedArray[StonecutterRecipe]
+}
+
+// RecipePropertySet lists the items accepted by a recipe slot,
+// e.g. "minecraft:furnace_input" or "minecraft:smithing_template".
+type RecipePropertySet struct {
+	Id ns.Identifier
+	// Items are item protocol IDs.
+	Items ns.PrefixedArray[ns.VarInt]
+}
+
+// StonecutterRecipe is a stonecutter recipe selectable in the stonecutter screen.
+type StonecutterRecipe struct {
+	Input   ns.IDSet
+	Display SlotDisplay
 }
 
 func (p *S2CUpdateRecipes) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if err := p.PropertySets.DecodeWith(buf, func(b *ns.PacketBuffer) (RecipePropertySet, error) {
+		var s RecipePropertySet
+		var err error
+		if s.Id, err = b.ReadIdentifier(); err != nil {
+			return s, err
+		}
+		err = s.Items.DecodeWith(b, func(b *ns.PacketBuffer) (ns.VarInt, error) {
+			return b.ReadVarInt()
+		})
+		return s, err
+	}); err != nil {
+		return err
+	}
+	return p.StonecutterRecipes.DecodeWith(buf, func(b *ns.PacketBuffer) (StonecutterRecipe, error) {
+		var r StonecutterRecipe
+		if err := r.Input.Decode(b); err != nil {
+			return r, err
+		}
+		var err error
+		r.Display, err = ReadSlotDisplay(b)
+		return r, err
+	})
 }
 
 func (p *S2CUpdateRecipes) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+	if err := p.PropertySets.EncodeWith(buf, func(b *ns.PacketBuffer, s RecipePropertySet) error {
+		if err := b.WriteIdentifier(s.Id); err != nil {
+			return err
+		}
+		return s.Items.EncodeWith(b, func(b *ns.PacketBuffer, v ns.VarInt) error {
+			return b.WriteVarInt(v)
+		})
+	}); err != nil {
+		return err
+	}
+	return p.StonecutterRecipes.EncodeWith(buf, func(b *ns.PacketBuffer, r StonecutterRecipe) error {
+		if err := r.Input.Encode(b); err != nil {
+			return err
+		}
+		return WriteSlotDisplay(b, r.Display)
+	})
 }
 
 // S2CUpdateTagsPlay represents "Update Tags (play)".
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Update_Tags_(Play)
 type S2CUpdateTagsPlay struct {
-	Data ns.ByteArray
+	ArrayOfTags []TagRegistry
 }
 
 func (p *S2CUpdateTagsPlay) Read(buf *ns.PacketBuffer) error {
 	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
+	p.ArrayOfTags, err = readTagRegistries(buf)
 	return err
 }
 
 func (p *S2CUpdateTagsPlay) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+	return writeTagRegistries(buf, p.ArrayOfTags)
 }
 
 // S2CProjectilePower represents "Projectile Power".
@@ -4555,17 +6979,174 @@ func (p *S2CServerLinksPlay) Write(buf *ns.PacketBuffer) error {
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Waypoint
 type S2CWaypoint struct {
-	Data ns.ByteArray
+	Operation ns.VarInt
+	Waypoint  TrackedWaypoint
+}
+
+// S2CWaypoint operations
+const (
+	WaypointTrack ns.VarInt = iota
+	WaypointUntrack
+	WaypointUpdate
+)
+
+// waypoint position types (TrackedWaypoint.Type)
+const (
+	WaypointEmpty ns.VarInt = iota
+	WaypointVec3i
+	WaypointChunk
+	WaypointAzimuth
+)
+
+// WaypointID identifies a waypoint by the UUID of its entity or by a name.
+type WaypointID struct {
+	IsUUID bool
+	UUID   ns.UUID
+	Name   ns.String
+}
+
+// String returns the UUID or the name.
+func (id WaypointID) String() string {
+	if id.IsUUID {
+		return id.UUID.String()
+	}
+	return string(id.Name)
+}
+
+// TrackedWaypoint is a waypoint shown on the locator bar.
+type TrackedWaypoint struct {
+	ID WaypointID
+	// Style is the waypoint style asset, e.g. "minecraft:default".
+	Style ns.Identifier
+	// Color is the optional 0xRRGGBB icon color.
+	Color ns.PrefixedOptional[ns.Int32]
+	Type  ns.VarInt
+	// X, Y and Z are the block position of vec3i waypoints. For chunk waypoints,
+	// X and Z are the chunk coordinates.
+	X, Y, Z ns.VarInt
+	// Azimuth is the direction of azimuth waypoints, as a yaw in radians.
+	Azimuth ns.Float32
 }
 
 func (p *S2CWaypoint) Read(buf *ns.PacketBuffer) error {
 	var err error
-	p.Data, err = buf.ReadByteArray(1048576)
-	return err
+	if p.Operation, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	return p.Waypoint.Read(buf)
 }
 
 func (p *S2CWaypoint) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Data)
+	if err := buf.WriteVarInt(p.Operation); err != nil {
+		return err
+	}
+	return p.Waypoint.Write(buf)
+}
+
+func (w *TrackedWaypoint) Read(buf *ns.PacketBuffer) error {
+	isUUID, err := buf.ReadBool()
+	if err != nil {
+		return err
+	}
+	w.ID = WaypointID{IsUUID: bool(isUUID)}
+	if w.ID.IsUUID {
+		if w.ID.UUID, err = buf.ReadUUID(); err != nil {
+			return err
+		}
+	} else if w.ID.Name, err = buf.ReadString(32767); err != nil {
+		return err
+	}
+	if w.Style, err = buf.ReadIdentifier(); err != nil {
+		return err
+	}
+	// the color is sent as 3 bytes, red first
+	if err = w.Color.DecodeWith(buf, func(b *ns.PacketBuffer) (ns.Int32, error) {
+		var rgb [3]byte
+		for i := range rgb {
+			var err error
+			if rgb[i], err = b.ReadByte(); err != nil {
+				return 0, err
+			}
+		}
+		return ns.Int32(rgb[0])<<16 | ns.Int32(rgb[1])<<8 | ns.Int32(rgb[2]), nil
+	}); err != nil {
+		return err
+	}
+	if w.Type, err = buf.ReadVarInt(); err != nil {
+		return err
+	}
+	w.X, w.Y, w.Z, w.Azimuth = 0, 0, 0, 0
+	switch w.Type {
+	case WaypointEmpty:
+	case WaypointVec3i:
+		if w.X, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		if w.Y, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		w.Z, err = buf.ReadVarInt()
+	case WaypointChunk:
+		if w.X, err = buf.ReadVarInt(); err != nil {
+			return err
+		}
+		w.Z, err = buf.ReadVarInt()
+	case WaypointAzimuth:
+		w.Azimuth, err = buf.ReadFloat32()
+	default:
+		return fmt.Errorf("unknown waypoint type %d", w.Type)
+	}
+	return err
+}
+
+func (w *TrackedWaypoint) Write(buf *ns.PacketBuffer) error {
+	if err := buf.WriteBool(ns.Boolean(w.ID.IsUUID)); err != nil {
+		return err
+	}
+	if w.ID.IsUUID {
+		if err := buf.WriteUUID(w.ID.UUID); err != nil {
+			return err
+		}
+	} else if err := buf.WriteString(w.ID.Name); err != nil {
+		return err
+	}
+	if err := buf.WriteIdentifier(w.Style); err != nil {
+		return err
+	}
+	if err := w.Color.EncodeWith(buf, func(b *ns.PacketBuffer, rgb ns.Int32) error {
+		for _, shift := range []int{16, 8, 0} {
+			if err := b.WriteByte(byte(rgb >> shift)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := buf.WriteVarInt(w.Type); err != nil {
+		return err
+	}
+	switch w.Type {
+	case WaypointEmpty:
+	case WaypointVec3i:
+		if err := buf.WriteVarInt(w.X); err != nil {
+			return err
+		}
+		if err := buf.WriteVarInt(w.Y); err != nil {
+			return err
+		}
+		return buf.WriteVarInt(w.Z)
+	case WaypointChunk:
+		if err := buf.WriteVarInt(w.X); err != nil {
+			return err
+		}
+		return buf.WriteVarInt(w.Z)
+	case WaypointAzimuth:
+		return buf.WriteFloat32(w.Azimuth)
+	default:
+		return fmt.Errorf("unknown waypoint type %d", w.Type)
+	}
+	return nil
 }
 
 // S2CClearDialogPlay represents "Clear Dialog (play)".
@@ -4580,17 +7161,29 @@ func (p *S2CClearDialogPlay) Write(*ns.PacketBuffer) error { return nil }
 //
 // https://minecraft.wiki/w/Java_Edition_protocol/Packets#Show_Dialog_(Play)
 type S2CShowDialogPlay struct {
-	Dialog ns.ByteArray
+	Dialog DialogHolder
+}
+
+// DialogHolder is a minecraft:dialog registry reference or an inline dialog definition,
+// as described at Java Edition protocol/Registry data#Dialog.
+type DialogHolder struct {
+	ns.IDOrX[nbt.Tag]
+}
+
+func (h *DialogHolder) Read(buf *ns.PacketBuffer) error {
+	return h.DecodeWith(buf, readNBT)
+}
+
+func (h DialogHolder) Write(buf *ns.PacketBuffer) error {
+	return h.EncodeWith(buf, writeNBT)
 }
 
 func (p *S2CShowDialogPlay) Read(buf *ns.PacketBuffer) error {
-	var err error
-	p.Dialog, err = buf.ReadByteArray(1048576)
-	return err
+	return p.Dialog.Read(buf)
 }
 
 func (p *S2CShowDialogPlay) Write(buf *ns.PacketBuffer) error {
-	return buf.WriteByteArray(p.Dialog)
+	return p.Dialog.Write(buf)
 }
 
 // S2CGameRuleValues represents "Game Rule Values" (new in 26.1).
@@ -0,0 +1,21 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// 64 stone mined, 3 zombies killed
+	capturedPackets[&packets.S2CAwardStats{Statistics: []packets.Statistic{
+		{StatType: 0, StatId: 1, Value: 64},
+		{StatType: 6, StatId: 150, Value: 3},
+	}}] = []byte{0x02, 0x00, 0x01, 0x40, 0x06, 0x96, 0x01, 0x03}
+}
+
+func TestAwardStats(t *testing.T) {
+	assert.Error(t, new(packets.S2CAwardStats).Read(ns.NewReader([]byte{0xff, 0xff, 0xff, 0xff, 0x0f})))
+}
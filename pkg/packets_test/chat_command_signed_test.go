@@ -0,0 +1,42 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// no signed arguments, nothing acknowledged
+	capturedPackets[&packets.C2SChatCommandSigned{
+		Command:      "msg alice hi",
+		Timestamp:    1_700_000_000_000,
+		Salt:         -1,
+		Acknowledged: ns.NewFixedBitSet(20),
+		Checksum:     1,
+	}] = hexToBytesMust("0c" + "6d736720616c696365206869" +
+		"0000018bcfe56800" + "ffffffffffffffff" +
+		"00" + // argument signatures
+		"00" + "000000" + "01")
+}
+
+func TestChatCommandSigned(t *testing.T) {
+	acknowledged := ns.NewFixedBitSet(20)
+	acknowledged.Set(19)
+	p := &packets.C2SChatCommandSigned{
+		Command:            "msg alice hello there",
+		Timestamp:          1_700_000_000_000,
+		Salt:               42,
+		ArgumentSignatures: []packets.ArgumentSignature{{Name: "message", Signature: packets.MessageSignature{0: 1, 255: 2}}},
+		MessageCount:       1,
+		Acknowledged:       acknowledged,
+		Checksum:           -7,
+	}
+	assert.Equal(t, p, encodeDecodePacket(t, p))
+
+	// more signed arguments than a command can have
+	err := new(packets.C2SChatCommandSigned).Read(ns.NewReader(hexToBytesMust("00" + "0000000000000000" + "0000000000000000" + "09")))
+	assert.ErrorContains(t, err, "argument signatures")
+}
@@ -0,0 +1,32 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/chunks"
+	"github.com/go-mclib/data/pkg/packets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunksBiomes(t *testing.T) {
+	col := &chunks.ChunkColumn{X: 4, Z: -1}
+	for i := range chunks.SectionCount {
+		col.Sections[i] = chunks.NewEmptySection()
+	}
+	col.Sections[5].SetBiome(1, 2, 3, 12)
+
+	p := &packets.S2CChunksBiomes{Chunks: []packets.ChunkBiomeData{
+		{ChunkX: 4, ChunkZ: -1, Biomes: col.Biomes()},
+	}}
+	decoded := encodeDecodePacket(t, p).(*packets.S2CChunksBiomes)
+	require.Len(t, decoded.Chunks, 1)
+	c := decoded.Chunks[0]
+	assert.Equal(t, p.Chunks[0].ChunkX, c.ChunkX)
+	assert.Equal(t, p.Chunks[0].ChunkZ, c.ChunkZ)
+
+	cached := &chunks.ChunkColumn{X: 4, Z: -1}
+	require.NoError(t, cached.ApplyBiomes(c.Biomes))
+	assert.Equal(t, int32(12), cached.Sections[5].GetBiome(1, 2, 3))
+	assert.Equal(t, int32(0), cached.Sections[5].GetBiome(0, 0, 0))
+}
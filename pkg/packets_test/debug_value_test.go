@@ -0,0 +1,87 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/debug"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// chunk Z comes first; raids have no value
+	capturedPackets[&packets.S2CDebugChunkValue{ChunkX: 2, ChunkZ: -1, Update: debug.Update{Subscription: debug.Raids}}] = []byte{
+		0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x02, 0x0b, 0x00,
+	}
+	capturedPackets[&packets.S2CDebugEntityValue{
+		EntityId: 5,
+		Update:   debug.Update{Subscription: debug.GameEventListeners, Value: &debug.GameEventListenerInfo{ListenerRadius: 16}},
+	}] = []byte{0x05, 0x0d, 0x01, 0x10}
+	capturedPackets[&packets.S2CDebugSample{
+		Sample:     []ns.Int64{50_000_000, 12_000_000},
+		SampleType: ns.VarInt(debug.SampleTickTime),
+	}] = hexToBytesMust("02" + "0000000002faf080" + "0000000000b71b00" + "00")
+}
+
+func TestDebugValues(t *testing.T) {
+	hive := ns.Position{X: 1, Y: 64, Z: -3}
+	attackTarget := int32(42)
+	for _, u := range []debug.Update{
+		{Subscription: debug.Bees, Value: &debug.BeeInfo{Hive: &hive, TravelTicks: 120, BlacklistedHives: []ns.Position{{X: 5, Y: 70, Z: 5}}}},
+		{Subscription: debug.Bees},
+		{Subscription: debug.Brains, Value: &debug.BrainDump{
+			Name: "Villager", Profession: "minecraft:farmer", XP: 10, Health: 18, MaxHealth: 20,
+			Inventory: "[wheat x3]", AngerLevel: 0, Activities: []string{"work"},
+			Behaviors: []string{"WorkAtPoi"}, Memories: []string{"job_site: ..."}, Gossips: []string{},
+			Pois: []ns.Position{hive}, PotentialPois: []ns.Position{},
+		}},
+		{Subscription: debug.Breezes, Value: &debug.BreezeInfo{AttackTarget: &attackTarget}},
+		{Subscription: debug.GoalSelectors, Value: &debug.GoalInfo{Goals: []debug.Goal{{Priority: 1, IsRunning: true, Name: "FloatGoal"}, {Priority: 2, Name: "MeleeAttackGoal"}}}},
+		{Subscription: debug.EntityPaths, Value: &debug.PathInfo{
+			Path: debug.Path{
+				NextNodeIndex: 1,
+				Target:        ns.Position{X: 10, Y: 64, Z: 10},
+				Nodes:         []debug.PathNode{{X: 0, Y: 64, Z: 0, Type: 1}, {X: 1, Y: 64, Z: 0, WalkedDistance: 1, F: 12.5, Closed: true}},
+				TargetNodes:   []debug.PathNode{{X: 10, Y: 64, Z: 10}},
+				OpenSet:       []debug.PathNode{},
+				ClosedSet:     []debug.PathNode{{X: 0, Y: 64, Z: 0}},
+			},
+			MaxNodeDistance: 1.5,
+		}},
+		{Subscription: debug.EntityBlockIntersections, Value: ptr(debug.IntersectionInFluid)},
+		{Subscription: debug.BeeHives, Value: &debug.HiveInfo{Block: "minecraft:bee_nest", OccupantCount: 3, HoneyLevel: 5, Sedated: true}},
+		{Subscription: debug.Pois, Value: &debug.PoiInfo{Pos: hive, Type: "minecraft:farmer", FreeTicketCount: 1}},
+		{Subscription: debug.RedstoneWireOrientations, Value: ptr(debug.Orientation(17))},
+		{Subscription: debug.VillageSections, Value: &debug.Unit{}},
+		{Subscription: debug.Raids, Value: &debug.RaidCenters{Centers: []ns.Position{hive}}},
+		{Subscription: debug.Structures, Value: &debug.StructureList{Structures: []debug.StructureInfo{{
+			BoundingBox: debug.BoundingBox{Min: ns.Position{X: 0, Y: 0, Z: 0}, Max: ns.Position{X: 15, Y: 40, Z: 15}},
+			Pieces:      []debug.StructurePiece{{BoundingBox: debug.BoundingBox{Max: ns.Position{X: 7, Y: 7, Z: 7}}, IsStart: true}},
+		}}}},
+		{Subscription: debug.GameEventListeners, Value: &debug.GameEventListenerInfo{ListenerRadius: 8}},
+	} {
+		t.Run(u.Subscription, func(t *testing.T) {
+			p := &packets.S2CDebugBlockValue{Location: hive, Update: u}
+			assert.Equal(t, p, encodeDecodePacket(t, p))
+		})
+	}
+
+	// a present value without an attack target
+	entity := encodeDecodePacket(t, &packets.S2CDebugEntityValue{EntityId: 5, Update: debug.Update{Subscription: debug.Breezes, Value: &debug.BreezeInfo{}}})
+	assert.Equal(t, &debug.BreezeInfo{}, entity.(*packets.S2CDebugEntityValue).Update.Value)
+}
+
+func TestDebugEvent(t *testing.T) {
+	for _, e := range []debug.Event{
+		{Subscription: debug.GameEvents, Value: &debug.GameEventInfo{Event: "minecraft:step", X: 1.5, Y: 64, Z: -2.25}},
+		{Subscription: debug.NeighborUpdates, Value: &debug.BlockPos{X: 3, Y: -60, Z: 9}},
+	} {
+		p := &packets.S2CDebugEvent{Event: e}
+		assert.Equal(t, p, encodeDecodePacket(t, p))
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,45 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/chat"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// team message: chat type ID + 1, sender name, then the target name
+	capturedPackets[&packets.S2CDisguisedChat{
+		Message:  ns.TextComponent{Text: "gg"},
+		ChatType: packets.ChatTypeBound{ChatType: 2, Name: ns.TextComponent{Text: "Steve"}, TargetName: ns.Some(ns.TextComponent{Text: "Red"})},
+	}] = []byte{
+		0x08, 0x00, 0x02, 'g', 'g',
+		0x03,
+		0x08, 0x00, 0x05, 'S', 't', 'e', 'v', 'e',
+		0x01, 0x08, 0x00, 0x03, 'R', 'e', 'd',
+	}
+}
+
+func TestChatTypeBound(t *testing.T) {
+	p := &packets.S2CPlayerChat{
+		Sender:          GoMclibPlayerUUID,
+		Body:            packets.SignedMessageBody{Content: "hello"},
+		UnsignedContent: ns.Some(ns.TextComponent{Text: "hi", Color: "red"}),
+		ChatType:        packets.ChatTypeBound{ChatType: 1, Name: ns.TextComponent{Text: string(GoMclibPlayerName)}},
+	}
+	decoded := encodeDecodePacket(t, p).(*packets.S2CPlayerChat)
+	assert.Equal(t, p.ChatType, decoded.ChatType)
+	assert.Equal(t, p.UnsignedContent, decoded.UnsignedContent)
+
+	// chat types can also be sent inline
+	direct, err := chat.VanillaChatType("minecraft:say_command")
+	require.NoError(t, err)
+	disguised := &packets.S2CDisguisedChat{
+		Message:  ns.TextComponent{Text: "restarting"},
+		ChatType: packets.ChatTypeBound{ChatType: -1, Direct: direct, Name: ns.TextComponent{Text: "Server"}},
+	}
+	assert.Equal(t, disguised, encodeDecodePacket(t, disguised))
+}
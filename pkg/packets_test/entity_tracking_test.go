@@ -0,0 +1,44 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	capturedPackets[&packets.S2CRemoveEntities{EntityIds: ns.PrefixedArray[ns.VarInt]{1, 300}}] = []byte{0x02, 0x01, 0xac, 0x02}
+	capturedPackets[&packets.S2CSetPassengers{EntityId: 1, Passengers: ns.PrefixedArray[ns.VarInt]{2, 3}}] = []byte{0x01, 0x02, 0x02, 0x03}
+	capturedPackets[&packets.S2CMoveEntityPos{EntityId: 7, DeltaX: 4096, DeltaZ: -2048, OnGround: true}] = []byte{
+		0x07, 0x10, 0x00, 0x00, 0x00, 0xf8, 0x00, 0x01,
+	}
+	capturedPackets[&packets.S2CMoveEntityRot{EntityId: 7, Yaw: 128, Pitch: 32}] = []byte{0x07, 0x80, 0x20, 0x00}
+
+	// the flags are a 32-bit relative bit set: X, Z, yaw and X velocity
+	capturedPackets[&packets.S2CTeleportEntity{
+		EntityId:  1,
+		X:         1,
+		Y:         80,
+		Z:         -1,
+		VelocityX: 0.5,
+		Yaw:       10,
+		Flags:     packets.TeleportRelativeX | packets.TeleportRelativeZ | packets.TeleportRelativeYaw | packets.TeleportRelativeVelocityX,
+	}] = hexToBytesMust("01" +
+		"3ff0000000000000" + "4054000000000000" + "bff0000000000000" + // position
+		"3fe0000000000000" + "0000000000000000" + "0000000000000000" + // velocity
+		"41200000" + "00000000" + // yaw, pitch
+		"0000002d" + "00")
+}
+
+func TestEntityPositionSync(t *testing.T) {
+	p := &packets.S2CEntityPositionSync{
+		EntityId: 1, X: 100.5, Y: 70, Z: -20.25, VelocityX: 0.1, Yaw: 45, Pitch: 10, OnGround: true,
+	}
+	assert.Equal(t, p, encodeDecodePacket(t, p))
+
+	motion := &packets.S2CSetEntityMotion{EntityId: 1, Velocity: ns.LpVec3{X: 1}}
+	decoded := encodeDecodePacket(t, motion).(*packets.S2CSetEntityMotion)
+	assert.InDelta(t, 1, float64(decoded.Velocity.X), 1e-3)
+}
@@ -0,0 +1,58 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/entities"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// no knockback, no block particles
+	capturedPackets[&packets.S2CExplode{
+		Y:                 64,
+		Radius:            4,
+		ExplosionParticle: entities.Particle{Type: "minecraft:explosion"},
+		ExplosionSound:    packets.NewSoundEventHolder("minecraft:entity.generic.explode"),
+	}] = []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // x
+		0x40, 0x50, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // y
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // z
+		0x40, 0x80, 0x00, 0x00, // radius
+		0x00, 0x00, 0x00, 0x00, // block count
+		0x00,       // knockback
+		0x17,       // explosion particle
+		0xba, 0x05, // sound event ID + 1
+		0x00,
+	}
+}
+
+func TestExplode(t *testing.T) {
+	p := &packets.S2CExplode{
+		X: 0.5, Y: 64, Z: 0.5,
+		Radius:            4,
+		BlockCount:        27,
+		PlayerKnockback:   ns.Some(packets.Vec3{X: 0.5, Y: 0.4, Z: 0}),
+		ExplosionParticle: entities.Particle{Type: "minecraft:explosion_emitter"},
+		ExplosionSound:    packets.NewSoundEventHolder("minecraft:entity.generic.explode"),
+		BlockParticles: ns.PrefixedArray[packets.ExplosionParticle]{
+			{Particle: entities.Particle{Type: "minecraft:poof"}, Scaling: 0.5, Speed: 1, Weight: 1},
+			{Particle: entities.Particle{Type: "minecraft:smoke"}, Scaling: 1, Speed: 1, Weight: 3},
+		},
+	}
+	assert.Equal(t, p, encodeDecodePacket(t, p))
+
+	inline := &packets.S2CExplode{
+		ExplosionParticle: entities.Particle{Type: "minecraft:explosion"},
+		ExplosionSound: packets.SoundEventHolder{IDOrX: ns.NewInlineValue(packets.SoundEvent{
+			SoundId:    "custom:boom",
+			FixedRange: ns.Some[ns.Float32](16),
+		})},
+		BlockParticles: ns.PrefixedArray[packets.ExplosionParticle]{
+			{Particle: entities.Particle{Type: "minecraft:dust", Options: &entities.DustParticleOptions{Color: 0x808080, Scale: 1}}, Scaling: 1, Speed: 0.5, Weight: 1},
+		},
+	}
+	assert.Equal(t, inline, encodeDecodePacket(t, inline))
+}
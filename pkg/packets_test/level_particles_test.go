@@ -0,0 +1,94 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/entities"
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelParticles(t *testing.T) {
+	particles := []entities.Particle{
+		{Type: "minecraft:flame"},
+		{Type: "minecraft:block", Options: &entities.BlockParticleOptions{BlockState: 1}},
+		{Type: "minecraft:dust", Options: &entities.DustParticleOptions{Color: 0xff0000, Scale: 1.5}},
+		{Type: "minecraft:dust_color_transition", Options: &entities.DustColorTransitionOptions{FromColor: 0xff0000, ToColor: 0x0000ff, Scale: 2}},
+		{Type: "minecraft:entity_effect", Options: &entities.ColorParticleOptions{Color: -1}},
+		{Type: "minecraft:instant_effect", Options: &entities.SpellParticleOptions{Color: 0x00ff00, Power: 0.5}},
+		{Type: "minecraft:dragon_breath", Options: &entities.PowerParticleOptions{Power: 1}},
+		{Type: "minecraft:vibration", Options: &entities.VibrationParticleOptions{
+			Destination:    entities.PositionSource{Type: "minecraft:block", Block: entities.Position{X: 1, Y: -60, Z: 3}},
+			ArrivalInTicks: 20,
+		}},
+		{Type: "minecraft:vibration", Options: &entities.VibrationParticleOptions{
+			Destination:    entities.PositionSource{Type: "minecraft:entity", EntityID: 42, YOffset: 1.62},
+			ArrivalInTicks: 5,
+		}},
+		{Type: "minecraft:trail", Options: &entities.TrailParticleOptions{TargetX: 1.5, TargetY: 64, TargetZ: -2.5, Color: 0x123456, Duration: 40}},
+		{Type: "minecraft:shriek", Options: &entities.ShriekParticleOptions{Delay: 10}},
+		{Type: "minecraft:sculk_charge", Options: &entities.SculkChargeParticleOptions{Roll: 3.14}},
+	}
+	for _, particle := range particles {
+		t.Run(particle.Type, func(t *testing.T) {
+			p := &packets.S2CLevelParticles{
+				LongDistance:  true,
+				X:             10.5,
+				Y:             70,
+				Z:             -3,
+				MaxSpeed:      0.1,
+				ParticleCount: 8,
+				Particle:      particle,
+			}
+			decoded := encodeDecodePacket(t, p).(*packets.S2CLevelParticles)
+			assert.Equal(t, p, decoded)
+		})
+	}
+
+	// item particles carry a full item stack
+	p := &packets.S2CLevelParticles{
+		ParticleCount: 1,
+		Particle: entities.Particle{Type: "minecraft:item", Options: &entities.ItemParticleOptions{
+			Item: items.NewStack(items.ItemID("minecraft:diamond"), 1),
+		}},
+	}
+	decoded := encodeDecodePacket(t, p).(*packets.S2CLevelParticles)
+	options, ok := decoded.Particle.Options.(*entities.ItemParticleOptions)
+	require.True(t, ok)
+	assert.Equal(t, items.ItemID("minecraft:diamond"), options.Item.ID)
+
+	// particle types with options cannot be written without them
+	buf := ns.NewWriter()
+	assert.Error(t, (&packets.S2CLevelParticles{Particle: entities.Particle{Type: "minecraft:dust"}}).Write(buf))
+	assert.NotNil(t, entities.NewParticle("minecraft:dust").Options)
+	assert.Nil(t, entities.NewParticle("minecraft:flame").Options)
+}
+
+func TestEffectParticlesMetadata(t *testing.T) {
+	particles := []entities.Particle{
+		{Type: "minecraft:entity_effect", Options: &entities.ColorParticleOptions{Color: 0x7f00ff00}},
+		{Type: "minecraft:dust", Options: &entities.DustParticleOptions{Color: 0xff0000, Scale: 1}},
+	}
+	value := ns.NewWriter()
+	require.NoError(t, entities.WriteParticles(value, particles))
+
+	// options are parsed, so entries following the particles decode correctly
+	buf := ns.NewWriter()
+	require.NoError(t, entities.WriteMetadata(buf, entities.Metadata{
+		{Index: entities.LivingEntityIndexEffectParticles, Serializer: entities.SerializerPARTICLES, Data: value.Bytes()},
+		{Index: entities.LivingEntityIndexEffectAmbient, Serializer: entities.SerializerBOOLEAN, Data: []byte{0x01}},
+	}))
+	metadata, err := entities.ReadMetadata(ns.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, metadata, 2)
+	assert.Equal(t, []byte{0x01}, metadata.Get(entities.LivingEntityIndexEffectAmbient))
+
+	decoded, err := entities.ReadParticles(ns.NewReader(metadata.Get(entities.LivingEntityIndexEffectParticles)))
+	require.NoError(t, err)
+	assert.Equal(t, particles, decoded)
+	assert.Equal(t, "[minecraft:entity_effect{Color:2130771712}, minecraft:dust{Color:16711680 Scale:1}]",
+		entities.FormatMetadataValue(entities.SerializerPARTICLES, metadata.Get(entities.LivingEntityIndexEffectParticles)))
+}
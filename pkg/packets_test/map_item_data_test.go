@@ -0,0 +1,26 @@
+package packets_test
+
+import (
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func init() {
+	// no decorations, no color patch
+	capturedPackets[&packets.S2CMapItemData{MapId: 5, Scale: 2}] = []byte{0x05, 0x02, 0x00, 0x00, 0x00}
+
+	capturedPackets[&packets.S2CMapItemData{
+		MapId:  3,
+		Scale:  1,
+		Locked: true,
+		Decorations: ns.Some(ns.PrefixedArray[packets.MapDecoration]{
+			{Type: 0, X: -10, Y: 20, Rotation: 8},
+		}),
+		ColorPatch: &packets.MapColorPatch{Width: 2, Height: 1, StartX: 10, StartY: 20, Colors: []byte{1*4 + 2, 0}},
+	}] = []byte{
+		0x03, 0x01, 0x01, // map ID, scale, locked
+		0x01, 0x01, 0x00, 0xf6, 0x14, 0x08, 0x00, // player marker at (-10, 20), rotation 8, no name
+		0x02, 0x01, 0x0a, 0x14, // 2x1 patch at (10, 20)
+		0x02, 0x06, 0x00,
+	}
+}
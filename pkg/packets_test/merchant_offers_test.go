@@ -0,0 +1,75 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerchantOffers(t *testing.T) {
+	emerald := ns.VarInt(items.ItemID("minecraft:emerald"))
+	book := ns.VarInt(items.ItemID("minecraft:book"))
+
+	named := &items.Components{CustomName: &items.ItemNameComponent{Text: "Rare Book"}}
+	named.SetPresent(items.ComponentCustomName)
+
+	offers := &packets.S2CMerchantOffers{
+		WindowId: 2,
+		Offers: ns.PrefixedArray[packets.MerchantOffer]{
+			{
+				CostA:           packets.ItemCost{Item: emerald, Count: 10},
+				Result:          items.NewStackWithComponents(items.ItemID("minecraft:bookshelf"), 1, &items.Components{}),
+				CostB:           ns.Some(packets.ItemCost{Item: book, Count: 1, Components: named}),
+				Uses:            3,
+				MaxUses:         12,
+				Xp:              5,
+				SpecialPrice:    -3,
+				PriceMultiplier: 0.05,
+				Demand:          4,
+			},
+		},
+		VillagerLevel:     2,
+		Experience:        40,
+		IsRegularVillager: true,
+		CanRestock:        true,
+	}
+	decoded := encodeDecodePacket(t, offers).(*packets.S2CMerchantOffers)
+	require.Len(t, decoded.Offers, 1)
+	assert.Equal(t, ns.VarInt(2), decoded.VillagerLevel)
+	assert.True(t, bool(decoded.CanRestock))
+
+	offer := &decoded.Offers[0]
+	assert.Equal(t, items.ItemID("minecraft:bookshelf"), offer.Result.ID)
+	assert.Nil(t, offer.CostA.Components)
+	costB, ok := offer.CostB.Get()
+	require.True(t, ok)
+	require.NotNil(t, costB.Components)
+	assert.True(t, costB.Components.HasComponent(items.ComponentCustomName))
+	assert.Equal(t, "Rare Book", costB.Components.CustomName.Text)
+
+	// 10 + floor(10 * 4 * 0.05) - 3
+	assert.Equal(t, int32(9), offer.CostACount())
+	assert.Equal(t, int32(9), offer.EmeraldPrice())
+	effective, err := offer.EffectiveCostA()
+	require.NoError(t, err)
+	assert.Equal(t, int32(9), effective.Count)
+
+	// the charged count is clamped to 1..max stack size
+	offer.SpecialPrice = -50
+	assert.Equal(t, int32(1), offer.CostACount())
+	offer.SpecialPrice = 0
+	offer.CostA.Count = 60
+	assert.Equal(t, int32(64), offer.CostACount())
+
+	// the exact component predicate must match
+	plainBook := items.NewStack(int32(book), 1)
+	assert.False(t, costB.Matches(plainBook))
+	namedBook, err := costB.Stack()
+	require.NoError(t, err)
+	assert.True(t, costB.Matches(namedBook))
+	assert.False(t, costB.Matches(items.NewStack(int32(emerald), 1)))
+}
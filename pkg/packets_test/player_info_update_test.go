@@ -0,0 +1,63 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// listed, then latency (VarInt 300)
+	capturedPackets[&packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoUpdateLatency | packets.PlayerInfoUpdateListed,
+		Entries: []packets.PlayerInfoEntry{{Uuid: GoMclibPlayerUUID, Listed: true, Latency: 300}},
+	}] = hexToBytesMust("1801f8ccd41b3ab832d1a575afb9913101d601ac02")
+
+	// name, one signed property, then game mode
+	capturedPackets[&packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoAddPlayer | packets.PlayerInfoUpdateGameMode,
+		Entries: []packets.PlayerInfoEntry{{
+			Uuid: GoMclibPlayerUUID,
+			Name: GoMclibPlayerName,
+			Properties: ns.PrefixedArray[ns.ProfileProperty]{
+				{Name: "textures", Value: "e30=", Signature: ns.Some[ns.String]("sig")},
+			},
+			GameMode: 1,
+		}},
+	}] = hexToBytesMust("0501f8ccd41b3ab832d1a575afb9913101d607476f4d636c6962" +
+		"01087465787475726573046533303d010373696701")
+
+	capturedPackets[&packets.S2CPlayerInfoRemove{
+		Uuids: []ns.UUID{GoMclibPlayerUUID},
+	}] = hexToBytesMust("01f8ccd41b3ab832d1a575afb9913101d6")
+}
+
+func TestPlayerInfoUpdate(t *testing.T) {
+	p := &packets.S2CPlayerInfoUpdate{
+		Actions: packets.PlayerInfoAddPlayer | packets.PlayerInfoInitializeChat | packets.PlayerInfoUpdateGameMode |
+			packets.PlayerInfoUpdateListed | packets.PlayerInfoUpdateLatency | packets.PlayerInfoUpdateDisplayName |
+			packets.PlayerInfoUpdateListOrder | packets.PlayerInfoUpdateHat,
+		Entries: []packets.PlayerInfoEntry{
+			{
+				Uuid:        GoMclibPlayerUUID,
+				Name:        GoMclibPlayerName,
+				Properties:  ns.PrefixedArray[ns.ProfileProperty]{},
+				ChatSession: ns.Some(packets.RemoteChatSession{SessionId: GoMclibPlayerUUID, ExpiresAt: 1234, PublicKey: []byte{1, 2}, KeySignature: []byte{3}}),
+				GameMode:    3,
+				Listed:      true,
+				Latency:     42,
+				DisplayName: ns.Some(ns.TextComponent{Text: "[Admin] GoMclib"}),
+				ListOrder:   -5,
+				ShowHat:     true,
+			},
+			{Uuid: GoMclibPlayerUUID, Name: "bob", Properties: ns.PrefixedArray[ns.ProfileProperty]{}, Listed: true},
+		},
+	}
+	assert.Equal(t, p, encodeDecodePacket(t, p))
+
+	// add player action with an entry count of -1
+	var decoded packets.S2CPlayerInfoUpdate
+	assert.Error(t, decoded.Read(ns.NewReader([]byte{0x01, 0xff, 0xff, 0xff, 0xff, 0x0f})))
+}
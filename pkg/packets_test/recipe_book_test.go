@@ -0,0 +1,108 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	stonecutting := &packets.StonecutterRecipeDisplay{
+		Input:           &packets.ItemSlotDisplay{Item: 1},
+		Result:          &packets.ItemSlotDisplay{Item: 2},
+		CraftingStation: &packets.ItemSlotDisplay{Item: 3},
+	}
+	// stonecutter display (3) of three item slot displays (4)
+	capturedPackets[&packets.S2CPlaceGhostRecipe{
+		WindowId:      3,
+		RecipeDisplay: stonecutting,
+	}] = []byte{0x03, 0x03, 0x04, 0x01, 0x04, 0x02, 0x04, 0x03}
+
+	capturedPackets[&packets.S2CRecipeBookAdd{
+		Entries: ns.PrefixedArray[packets.RecipeBookEntry]{
+			{Id: 9, Display: stonecutting, Group: -1, Category: 3, Flags: packets.RecipeBookHighlight},
+		},
+		Replace: true,
+	}] = []byte{
+		0x01, // entries
+		0x09, 0x03, 0x04, 0x01, 0x04, 0x02, 0x04, 0x03,
+		0x00, // group + 1
+		0x03, // category
+		0x00, // no crafting requirements
+		0x02, // flags
+		0x01, // replace
+	}
+
+	capturedPackets[&packets.S2CRecipeBookRemove{
+		Recipes: ns.PrefixedArray[ns.VarInt]{2},
+	}] = []byte{0x01, 0x02}
+
+	capturedPackets[&packets.S2CUpdateRecipes{
+		PropertySets: ns.PrefixedArray[packets.RecipePropertySet]{
+			{Id: "a:b", Items: ns.PrefixedArray[ns.VarInt]{5}},
+		},
+		StonecutterRecipes: ns.PrefixedArray[packets.StonecutterRecipe]{
+			{Input: *ns.NewInlineIDSet([]ns.VarInt{1}), Display: &packets.ItemSlotDisplay{Item: 2}},
+		},
+	}] = []byte{
+		0x01, 0x03, 'a', ':', 'b', 0x01, 0x05, // property sets
+		0x01, 0x02, 0x01, 0x04, 0x02, // stonecutter recipes
+	}
+}
+
+func TestRecipeDisplays(t *testing.T) {
+	item := func(name string) *packets.ItemSlotDisplay {
+		return &packets.ItemSlotDisplay{Item: ns.VarInt(items.ItemID(name))}
+	}
+	displays := []packets.RecipeDisplay{
+		&packets.ShapedCraftingRecipeDisplay{
+			Width: 1, Height: 2,
+			Ingredients:     []packets.SlotDisplay{&packets.TagSlotDisplay{Tag: "minecraft:planks"}, &packets.TagSlotDisplay{Tag: "minecraft:planks"}},
+			Result:          item("minecraft:stick"),
+			CraftingStation: item("minecraft:crafting_table"),
+		},
+		&packets.ShapelessCraftingRecipeDisplay{
+			Ingredients: []packets.SlotDisplay{
+				&packets.WithRemainderSlotDisplay{Input: item("minecraft:milk_bucket"), Remainder: item("minecraft:bucket")},
+				&packets.OnlyWithComponentSlotDisplay{Display: item("minecraft:oak_planks"), Component: 3},
+				&packets.DyedSlotDisplay{Dye: &packets.TagSlotDisplay{Tag: "minecraft:dyes"}, Target: item("minecraft:oak_planks")},
+				&packets.WithAnyPotionSlotDisplay{Display: item("minecraft:oak_planks")},
+			},
+			Result:          item("minecraft:oak_planks"),
+			CraftingStation: &packets.EmptySlotDisplay{},
+		},
+		&packets.FurnaceRecipeDisplay{
+			Ingredient:      item("minecraft:raw_iron"),
+			Fuel:            &packets.AnyFuelSlotDisplay{},
+			Result:          item("minecraft:iron_ingot"),
+			CraftingStation: item("minecraft:furnace"),
+			Duration:        200,
+			Experience:      0.7,
+		},
+		&packets.SmithingRecipeDisplay{
+			Template: item("minecraft:coast_armor_trim_smithing_template"),
+			Base:     item("minecraft:iron_chestplate"),
+			Addition: item("minecraft:iron_ingot"),
+			Result: &packets.SmithingTrimSlotDisplay{
+				Base:     item("minecraft:iron_chestplate"),
+				Material: item("minecraft:iron_ingot"),
+				Pattern: ns.NewInlineValue(packets.TrimPattern{
+					AssetId:      "minecraft:coast",
+					TemplateItem: ns.VarInt(items.ItemID("minecraft:coast_armor_trim_smithing_template")),
+					Description:  ns.TextComponent{Text: "Coast"},
+					Decal:        true,
+				}),
+			},
+			CraftingStation: &packets.CompositeSlotDisplay{},
+		},
+	}
+	for _, d := range displays {
+		t.Run(d.RecipeDisplayType(), func(t *testing.T) {
+			p := &packets.S2CPlaceGhostRecipe{WindowId: 3, RecipeDisplay: d}
+			assert.Equal(t, p, encodeDecodePacket(t, p))
+		})
+	}
+}
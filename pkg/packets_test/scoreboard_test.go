@@ -0,0 +1,67 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	capturedPackets[&packets.S2CSetDisplayObjective{Position: packets.DisplaySlotSidebar, ScoreName: "kills"}] = []byte{
+		0x01, 0x05, 'k', 'i', 'l', 'l', 's',
+	}
+	capturedPackets[&packets.S2CSetObjective{ObjectiveName: "kills", Mode: packets.ObjectiveModeRemove}] = []byte{
+		0x05, 'k', 'i', 'l', 'l', 's', 0x01,
+	}
+	// no display name, no number format
+	capturedPackets[&packets.S2CSetScore{EntityName: "Alice", ObjectiveName: "kills", Value: -3}] = []byte{
+		0x05, 'A', 'l', 'i', 'c', 'e',
+		0x05, 'k', 'i', 'l', 'l', 's',
+		0xfd, 0xff, 0xff, 0xff, 0x0f,
+		0x00, 0x00,
+	}
+	capturedPackets[&packets.S2CResetScore{EntityName: "Carol"}] = []byte{
+		0x05, 'C', 'a', 'r', 'o', 'l', 0x00,
+	}
+	capturedPackets[&packets.S2CResetScore{EntityName: "bob", ObjectiveName: ns.Some[ns.String]("kills")}] = []byte{
+		0x03, 'b', 'o', 'b', 0x01, 0x05, 'k', 'i', 'l', 'l', 's',
+	}
+	capturedPackets[&packets.S2CSetPlayerTeam{TeamName: "red", Method: packets.TeamMethodRemovePlayers, Players: ns.PrefixedArray[ns.String]{"Bob"}}] = []byte{
+		0x03, 'r', 'e', 'd', 0x04, 0x01, 0x03, 'B', 'o', 'b',
+	}
+}
+
+func TestScoreboardPackets(t *testing.T) {
+	objective := &packets.S2CSetObjective{
+		ObjectiveName: "kills",
+		Mode:          packets.ObjectiveModeCreate,
+		DisplayName:   ns.TextComponent{Text: "Kills"},
+		RenderType:    packets.ObjectiveRenderHearts,
+		NumberFormat:  ns.Some(packets.NumberFormat{Type: packets.NumberFormatFixed, Content: ns.TextComponent{Text: "-"}}),
+	}
+	assert.Equal(t, objective, encodeDecodePacket(t, objective))
+
+	score := &packets.S2CSetScore{
+		EntityName:    "Alice",
+		ObjectiveName: "kills",
+		Value:         -3,
+		DisplayName:   ns.Some(ns.TextComponent{Text: "Al"}),
+		NumberFormat:  ns.Some(packets.NumberFormat{Type: packets.NumberFormatBlank}),
+	}
+	assert.Equal(t, score, encodeDecodePacket(t, score))
+
+	team := &packets.S2CSetPlayerTeam{
+		TeamName:          "red",
+		Method:            packets.TeamMethodCreate,
+		DisplayName:       ns.TextComponent{Text: "Red"},
+		Options:           packets.TeamFriendlyFire,
+		NameTagVisibility: packets.NameTagHideForOtherTeams,
+		CollisionRule:     packets.CollisionPushOwnTeam,
+		Color:             12,
+		Prefix:            ns.TextComponent{Text: "[R] "},
+		Players:           ns.PrefixedArray[ns.String]{"Alice", "Bob"},
+	}
+	assert.Equal(t, team, encodeDecodePacket(t, team))
+}
@@ -0,0 +1,46 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetEquipment(t *testing.T) {
+	p := &packets.S2CSetEquipment{
+		EntityId: 12,
+		Equipment: map[packets.EquipmentSlot]*items.ItemStack{
+			packets.EquipmentMainHand: items.NewStack(items.ItemID("minecraft:diamond_sword"), 1),
+			packets.EquipmentHead:     items.NewStack(items.ItemID("minecraft:netherite_helmet"), 1),
+			packets.EquipmentChest:    items.NewStack(items.ItemID("minecraft:elytra"), 1),
+			packets.EquipmentOffHand:  items.EmptyStack(),
+			packets.EquipmentSaddle:   items.NewStack(items.ItemID("minecraft:saddle"), 1),
+		},
+	}
+	decoded := encodeDecodePacket(t, p).(*packets.S2CSetEquipment)
+	assert.Equal(t, p.EntityId, decoded.EntityId)
+	require.Len(t, decoded.Equipment, len(p.Equipment))
+	for slot, stack := range p.Equipment {
+		require.Contains(t, decoded.Equipment, slot)
+		assert.Equal(t, stack.ID, decoded.Equipment[slot].ID, slot.String())
+		assert.Equal(t, stack.Count, decoded.Equipment[slot].Count, slot.String())
+	}
+
+	// entries are written in slot order, with the high bit set on all but the last
+	buf := ns.NewWriter()
+	require.NoError(t, (&packets.S2CSetEquipment{
+		EntityId:  1,
+		Equipment: map[packets.EquipmentSlot]*items.ItemStack{packets.EquipmentFeet: nil, packets.EquipmentMainHand: nil},
+	}).Write(buf))
+	assert.Equal(t, []byte{0x01, 0x80, 0x00, 0x02, 0x00}, buf.Bytes())
+
+	assert.Error(t, (&packets.S2CSetEquipment{EntityId: 1}).Write(ns.NewWriter()))
+
+	assert.Equal(t, "head", packets.EquipmentHead.String())
+	assert.True(t, packets.EquipmentLegs.IsArmor())
+	assert.False(t, packets.EquipmentBody.IsArmor())
+}
@@ -0,0 +1,31 @@
+package packets_test
+
+import (
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/go-mclib/protocol/nbt"
+)
+
+func init() {
+	// registered dialogs are sent by ID + 1
+	capturedPackets[&packets.S2CShowDialogPlay{Dialog: packets.DialogHolder{IDOrX: ns.NewIDRef[nbt.Tag](1)}}] = []byte{0x02}
+
+	notice := nbt.Compound{"type": nbt.String("notice")}
+	noticeNBT := []byte{
+		0x0a,
+		0x08, 0x00, 0x04, 't', 'y', 'p', 'e', 0x00, 0x06, 'n', 'o', 't', 'i', 'c', 'e',
+		0x00,
+	}
+	capturedPackets[&packets.S2CShowDialogPlay{Dialog: packets.DialogHolder{IDOrX: ns.NewInlineValue[nbt.Tag](notice)}}] = append([]byte{0x00}, noticeNBT...)
+	capturedPackets[&packets.S2CShowDialogConfiguration{Dialog: notice}] = noticeNBT
+
+	// the payload is prefixed with its length
+	capturedPackets[&packets.C2SCustomClickActionPlay{Id: "example:confirm", Payload: nbt.Compound{"ok": nbt.Byte(1)}}] = []byte{
+		0x0f, 'e', 'x', 'a', 'm', 'p', 'l', 'e', ':', 'c', 'o', 'n', 'f', 'i', 'r', 'm',
+		0x08, 0x0a, 0x01, 0x00, 0x02, 'o', 'k', 0x01, 0x00,
+	}
+	capturedPackets[&packets.C2SCustomClickActionConfiguration{Id: "example:submit", Payload: nbt.Compound{"size": nbt.Float(3)}}] = []byte{
+		0x0e, 'e', 'x', 'a', 'm', 'p', 'l', 'e', ':', 's', 'u', 'b', 'm', 'i', 't',
+		0x0d, 0x0a, 0x05, 0x00, 0x04, 's', 'i', 'z', 'e', 0x40, 0x40, 0x00, 0x00, 0x00,
+	}
+}
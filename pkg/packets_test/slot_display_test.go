@@ -0,0 +1,40 @@
+package packets_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlotDisplay(t *testing.T) {
+	buf := ns.NewWriter()
+	require.NoError(t, packets.WriteSlotDisplay(buf, &packets.CompositeSlotDisplay{
+		Contents: []packets.SlotDisplay{nil, &packets.TagSlotDisplay{Tag: "a:b"}},
+	}))
+	assert.Equal(t, []byte{10, 2, 0, 6, 3, 'a', ':', 'b'}, buf.Bytes())
+
+	_, err := packets.ReadSlotDisplay(ns.NewReader([]byte{100}))
+	assert.Error(t, err)
+}
+
+func TestSlotDisplayLimits(t *testing.T) {
+	// composite with -1 contents
+	_, err := packets.ReadSlotDisplay(ns.NewReader([]byte{10, 0xff, 0xff, 0xff, 0xff, 0x0f}))
+	assert.Error(t, err)
+
+	// composites of a single composite, nested n times, around an empty display
+	nested := func(n int) []byte {
+		return append(bytes.Repeat([]byte{10, 1}, n), 0)
+	}
+	d, err := packets.ReadSlotDisplay(ns.NewReader(nested(16)))
+	require.NoError(t, err)
+	assert.IsType(t, &packets.CompositeSlotDisplay{}, d)
+	_, err = packets.ReadSlotDisplay(ns.NewReader(nested(17)))
+	assert.Error(t, err)
+	_, err = packets.ReadSlotDisplay(ns.NewReader(nested(100000)))
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// unregistered sounds are sent inline
+	capturedPackets[&packets.S2CSoundEntity{
+		SoundEvent:    packets.NewSoundEventHolder("custom:alarm"),
+		SoundCategory: packets.SoundSourceVoice,
+		EntityId:      7,
+		Volume:        2,
+		Pitch:         1,
+	}] = []byte{
+		0x00, 0x0c, 'c', 'u', 's', 't', 'o', 'm', ':', 'a', 'l', 'a', 'r', 'm', 0x00, // inline, no fixed range
+		0x09, 0x07, // voice, entity ID
+		0x40, 0x00, 0x00, 0x00, 0x3f, 0x80, 0x00, 0x00, // volume, pitch
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // seed
+	}
+}
+
+func TestSound(t *testing.T) {
+	p := &packets.S2CSound{
+		SoundEvent:      packets.NewSoundEventHolder("minecraft:entity.creeper.primed"),
+		SoundCategory:   packets.SoundSourceHostile,
+		EffectPositionX: 80,
+		EffectPositionY: 512,
+		EffectPositionZ: -16,
+		Volume:          1,
+		Pitch:           0.5,
+		Seed:            1234,
+	}
+	decoded := encodeDecodePacket(t, p).(*packets.S2CSound)
+	assert.Equal(t, p, decoded)
+	assert.False(t, decoded.SoundEvent.IsInline)
+	assert.Equal(t, "minecraft:entity.creeper.primed", decoded.SoundEvent.Name())
+}
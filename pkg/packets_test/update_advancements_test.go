@@ -0,0 +1,92 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/items"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	capturedPackets[&packets.S2CUpdateAdvancements{
+		Progress: ns.PrefixedArray[packets.AdvancementProgress]{{
+			Id: "a:b",
+			Criteria: ns.PrefixedArray[packets.CriterionProgress]{
+				{Criterion: "x", ObtainedAt: ns.Some[ns.Int64](1)},
+				{Criterion: "y"},
+			},
+		}},
+		ShowAdvancements: true,
+	}] = []byte{
+		0x00, // reset
+		0x00, // added
+		0x00, // removed
+		0x01, // progress
+		0x03, 'a', ':', 'b',
+		0x02, // criteria
+		0x01, 'x', 0x01, 0, 0, 0, 0, 0, 0, 0, 1,
+		0x01, 'y', 0x00,
+		0x01, // show advancements
+	}
+}
+
+func TestUpdateAdvancements(t *testing.T) {
+	p := &packets.S2CUpdateAdvancements{
+		Reset: true,
+		Added: ns.PrefixedArray[packets.AdvancementHolder]{
+			{
+				Id: "minecraft:story/root",
+				Advancement: packets.Advancement{
+					Display: ns.Some(packets.AdvancementDisplay{
+						Title:       ns.TextComponent{Text: "Minecraft"},
+						Description: ns.TextComponent{Text: "The heart and story of the game"},
+						Icon:        items.NewStackWithComponents(items.ItemID("minecraft:grass_block"), 1, &items.Components{}),
+						Frame:       packets.AdvancementFrameTask,
+						Flags:       packets.AdvancementHasBackground,
+						Background:  "minecraft:gui/advancements/backgrounds/stone",
+					}),
+					Requirements: ns.PrefixedArray[ns.PrefixedArray[ns.String]]{{"crafting_table"}},
+				},
+			},
+			{
+				Id: "minecraft:story/smelt_iron",
+				Advancement: packets.Advancement{
+					Parent: ns.Some[ns.Identifier]("minecraft:story/root"),
+					Display: ns.Some(packets.AdvancementDisplay{
+						Title:       ns.TextComponent{Text: "Acquire Hardware"},
+						Description: ns.TextComponent{Text: "Smelt an Iron Ingot"},
+						Icon:        items.NewStackWithComponents(items.ItemID("minecraft:iron_ingot"), 1, &items.Components{}),
+						Frame:       packets.AdvancementFrameGoal,
+						Flags:       packets.AdvancementShowToast,
+						X:           1,
+						Y:           2.5,
+					}),
+					Requirements:        ns.PrefixedArray[ns.PrefixedArray[ns.String]]{{"iron"}, {"furnace", "blast_furnace"}},
+					SendsTelemetryEvent: true,
+				},
+			},
+		},
+		Removed: ns.PrefixedArray[ns.Identifier]{"minecraft:recipes/misc/stick"},
+	}
+	decoded := encodeDecodePacket(t, p).(*packets.S2CUpdateAdvancements)
+	require.Len(t, decoded.Added, 2)
+	root, ok := decoded.Added[0].Advancement.Display.Get()
+	require.True(t, ok)
+	assert.Equal(t, items.ItemID("minecraft:grass_block"), root.Icon.ID)
+	// the background is only sent with the has background flag
+	assert.Equal(t, ns.Identifier("minecraft:gui/advancements/backgrounds/stone"), root.Background)
+
+	iron := decoded.Added[1].Advancement
+	assert.Equal(t, p.Added[1].Advancement.Parent, iron.Parent)
+	assert.Equal(t, p.Added[1].Advancement.Requirements, iron.Requirements)
+	assert.True(t, bool(iron.SendsTelemetryEvent))
+	display, ok := iron.Display.Get()
+	require.True(t, ok)
+	assert.Equal(t, "Acquire Hardware", display.Title.Text)
+	assert.Equal(t, packets.AdvancementFrameGoal, display.Frame)
+	assert.Equal(t, ns.Float32(2.5), display.Y)
+	assert.Equal(t, p.Removed, decoded.Removed)
+}
@@ -0,0 +1,22 @@
+package packets_test
+
+import (
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+)
+
+func init() {
+	// sprinting on top of the base movement speed
+	capturedPackets[&packets.S2CUpdateAttributes{
+		EntityId: 42,
+		Attributes: ns.PrefixedArray[packets.AttributeSnapshot]{
+			{
+				Attribute: 22,
+				Base:      0.1,
+				Modifiers: ns.PrefixedArray[packets.AttributeModifier]{
+					{Id: "minecraft:sprinting", Amount: 0.3, Operation: packets.AttributeAddMultipliedTotal},
+				},
+			},
+		},
+	}] = hexToBytesMust("2a01163fb999999999999a0113" + "6d696e6563726166743a737072696e74696e67" + "3fd333333333333302")
+}
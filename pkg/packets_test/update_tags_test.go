@@ -0,0 +1,49 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/data/registries"
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateTags(t *testing.T) {
+	stone := ns.VarInt(registries.Block.Get("minecraft:stone"))
+	tags := []packets.TagRegistry{
+		{
+			Registry: "minecraft:block",
+			Tags: []packets.Tag{
+				{TagName: "minecraft:mineable/pickaxe", Entries: []ns.VarInt{stone}},
+				{TagName: "custom:empty", Entries: []ns.VarInt{}},
+			},
+		},
+		{Registry: "minecraft:item", Tags: []packets.Tag{}},
+	}
+
+	play := encodeDecodePacket(t, &packets.S2CUpdateTagsPlay{ArrayOfTags: tags}).(*packets.S2CUpdateTagsPlay)
+	assert.Equal(t, tags, play.ArrayOfTags)
+
+	config := encodeDecodePacket(t, &packets.S2CUpdateTagsConfiguration{ArrayOfTags: tags}).(*packets.S2CUpdateTagsConfiguration)
+	assert.Equal(t, tags, config.ArrayOfTags)
+
+	tagMap := play.ArrayOfTags[0].TagMap()
+	require.Contains(t, tagMap, "minecraft:mineable/pickaxe")
+	assert.Equal(t, []int32{int32(stone)}, tagMap["minecraft:mineable/pickaxe"])
+	assert.Empty(t, tagMap["custom:empty"])
+
+	// negative registry, tag and entry counts, then huge counts that are not
+	// followed by as many entries
+	for _, data := range [][]byte{
+		{0xff, 0xff, 0xff, 0xff, 0x0f},
+		{0x01, 0x01, 'a', 0xff, 0xff, 0xff, 0xff, 0x0f},
+		{0x01, 0x01, 'a', 0x01, 0x01, 'b', 0xff, 0xff, 0xff, 0xff, 0x0f},
+		{0xff, 0xff, 0xff, 0xff, 0x07},
+		{0x01, 0x01, 'a', 0xff, 0xff, 0xff, 0xff, 0x07},
+		{0x01, 0x01, 'a', 0x01, 0x01, 'b', 0xff, 0xff, 0xff, 0xff, 0x07},
+	} {
+		assert.Error(t, new(packets.S2CUpdateTagsPlay).Read(ns.NewReader(data)))
+	}
+}
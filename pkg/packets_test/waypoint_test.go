@@ -0,0 +1,43 @@
+package packets_test
+
+import (
+	"testing"
+
+	"github.com/go-mclib/data/pkg/packets"
+	ns "github.com/go-mclib/protocol/java_protocol/net_structures"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// a named chunk waypoint with a color
+	capturedPackets[&packets.S2CWaypoint{
+		Operation: packets.WaypointTrack,
+		Waypoint: packets.TrackedWaypoint{
+			ID:    packets.WaypointID{Name: "home"},
+			Style: "minecraft:bowtie",
+			Color: ns.Some[ns.Int32](0x12ab34),
+			Type:  packets.WaypointChunk,
+			X:     3,
+			Z:     -1,
+		},
+	}] = []byte{
+		0x00, 0x00, 0x04, 'h', 'o', 'm', 'e',
+		0x10, 'm', 'i', 'n', 'e', 'c', 'r', 'a', 'f', 't', ':', 'b', 'o', 'w', 't', 'i', 'e',
+		0x01, 0x12, 0xab, 0x34,
+		0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0x0f,
+	}
+}
+
+func TestWaypoint(t *testing.T) {
+	uuid := ns.UUID{0: 0x01, 15: 0xff}
+	for _, w := range []packets.TrackedWaypoint{
+		{ID: packets.WaypointID{IsUUID: true, UUID: uuid}, Style: "minecraft:default", Type: packets.WaypointEmpty},
+		{ID: packets.WaypointID{IsUUID: true, UUID: uuid}, Style: "minecraft:default", Type: packets.WaypointVec3i, X: 10, Y: -64, Z: 300},
+		{ID: packets.WaypointID{IsUUID: true, UUID: uuid}, Style: "minecraft:default", Type: packets.WaypointAzimuth, Azimuth: 1.5},
+	} {
+		p := &packets.S2CWaypoint{Operation: packets.WaypointUpdate, Waypoint: w}
+		assert.Equal(t, p, encodeDecodePacket(t, p))
+	}
+
+	assert.Error(t, (&packets.S2CWaypoint{Waypoint: packets.TrackedWaypoint{Type: 4}}).Write(ns.NewWriter()))
+}